@@ -1,10 +1,406 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/optimize"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
 )
 
+const defaultConfigDir = "internal/config/set15"
+
 func main() {
-	fmt.Println("SFT: Simfight-Tactics CLI — MVP-0 placeholder")
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "sensitivity":
+		err = runSensitivity(os.Args[2:])
+	case "bis":
+		err = runBIS(os.Args[2:])
+	case "plan":
+		err = runPlan(os.Args[2:])
+	case "comp":
+		err = runComp(os.Args[2:])
+	case "position":
+		err = runPosition(os.Args[2:])
+	case "powerups":
+		err = runPowerUps(os.Args[2:])
+	case "-h", "--help", "help":
+		usage()
+	default:
+		fmt.Fprintf(os.Stderr, "sft: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sft: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `SFT: Simfight-Tactics CLI
+
+Usage:
+  sft sensitivity -scenario FILE -unit NAME [flags]   stat weights for one unit
+  sft bis -scenario FILE -unit NAME [flags]           best item builds for one unit
+  sft plan -scenario FILE -bag COMPONENTS [flags]     slam and hand out a bag of components
+  sft comp -roster FILE -level N [flags]              team compositions by active traits
+  sft position -scenario FILE [flags]                 best blue layout against the red board
+  sft powerups -scenario FILE -unit NAME [flags]      power-ups ranked for one unit`)
+}
+
+func runSensitivity(args []string) error {
+	fs := flag.NewFlagSet("sensitivity", flag.ContinueOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, abilities)")
+	unit := fs.String("unit", "", "unit to analyze")
+	runs := fs.Int("runs", 500, "Monte-Carlo runs per perturbation")
+	paths := fs.String("stats", "", "comma-separated stat paths or aliases (default: all)")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioPath == "" || *unit == "" {
+		return fmt.Errorf("sensitivity: -scenario and -unit are required")
+	}
+
+	s, err := loadScenario(*scenarioPath, *configDir)
+	if err != nil {
+		return err
+	}
+	cfg := sim.SensitivityConfig{MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs}}
+	if *paths != "" {
+		cfg.Paths = strings.Split(*paths, ",")
+	}
+	res, err := sim.Sensitivity(s, *unit, cfg)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("%s: base DPS %.1f (%d runs)\n\n", res.Unit, res.BaseDPS, *runs)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "rank\tstat\tdelta\tDPS gain\tper unit\tnormalized")
+	for _, sw := range res.Weights {
+		fmt.Fprintf(w, "%d\t%s\t%+g\t%+.2f\t%+.3f\t%+.2f\n", sw.Rank, sw.Path, sw.Delta, sw.Gain, sw.PerUnit, sw.Normalized)
+	}
+	return w.Flush()
+}
+
+func runBIS(args []string) error {
+	fs := flag.NewFlagSet("bis", flag.ContinueOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, abilities, items)")
+	unit := fs.String("unit", "", "unit to build items for")
+	objective := fs.String("objective", "dps", "dps, ttk, survival or win_rate")
+	pool := fs.String("pool", "", "comma-separated available components (default: any build)")
+	keep := fs.Int("keep", 16, "builds simulated after analytical pruning")
+	top := fs.Int("top", 5, "builds shown")
+	runs := fs.Int("runs", 500, "Monte-Carlo runs per simulated build")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioPath == "" || *unit == "" {
+		return fmt.Errorf("bis: -scenario and -unit are required")
+	}
+
+	s, err := loadScenario(*scenarioPath, *configDir)
+	if err != nil {
+		return err
+	}
+	catalog, err := items.LoadItems(filepath.Join(*configDir, "items.json"))
+	if err != nil {
+		return err
+	}
+	obj, err := optimize.ParseObjective(*objective)
+	if err != nil {
+		return err
+	}
+	cfg := optimize.BISConfig{
+		Objective: obj, Keep: *keep, Top: *top,
+		MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs},
+	}
+	if *pool != "" {
+		cfg.Pool = strings.Split(*pool, ",")
+	}
+	res, err := optimize.BestInSlot(s, *unit, catalog, cfg)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("%s: best %s builds (%d legal, %d simulated, %d runs each)\n\n", res.Unit, res.Objective, res.Candidates, res.Simulated, *runs)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "rank\titems\testimate\tmean\t95% CI")
+	for _, b := range res.Builds {
+		fmt.Fprintf(w, "%d\t%s\t%.1f\t%.2f\t[%.2f, %.2f]\n", b.Rank, strings.Join(b.Items, ", "), b.Estimate, b.Score.Mean, b.Score.Low, b.Score.High)
+	}
+	return w.Flush()
+}
+
+func runPlan(args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file (blue is our board, red the reference enemy)")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, abilities, items)")
+	bag := fs.String("bag", "", "comma-separated components held")
+	objective := fs.String("objective", "dps", "dps, ttk, survival or win_rate (team)")
+	top := fs.Int("top", 5, "plans shown")
+	simulate := fs.Bool("simulate", false, "rank the best plans by Monte-Carlo instead of the estimate")
+	keep := fs.Int("keep", 16, "plans simulated with -simulate")
+	runs := fs.Int("runs", 300, "Monte-Carlo runs per simulated plan")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioPath == "" || *bag == "" {
+		return fmt.Errorf("plan: -scenario and -bag are required")
+	}
+
+	s, err := loadScenario(*scenarioPath, *configDir)
+	if err != nil {
+		return err
+	}
+	catalog, err := items.LoadItems(filepath.Join(*configDir, "items.json"))
+	if err != nil {
+		return err
+	}
+	obj, err := optimize.ParseObjective(*objective)
+	if err != nil {
+		return err
+	}
+	res, err := optimize.PlanItems(s, strings.Split(*bag, ","), catalog, optimize.PlanConfig{
+		Objective: obj, Top: *top, Simulate: *simulate, Keep: *keep,
+		MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs},
+	})
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("%d crafts, %d plans (%s)\n\n", res.Crafts, res.Plans, res.Objective)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "rank\titems\tleftover\testimate\tsimulated")
+	for _, p := range res.Best {
+		var given []string
+		for _, a := range p.Assignments {
+			given = append(given, a.Unit+": "+strings.Join(a.Items, " + "))
+		}
+		score := "-"
+		if p.Score != nil {
+			score = fmt.Sprintf("%.2f [%.2f, %.2f]", p.Score.Mean, p.Score.Low, p.Score.High)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%.1f\t%s\n", p.Rank, strings.Join(given, "; "), strings.Join(p.Leftover, ", "), p.Estimate, score)
+	}
+	return w.Flush()
+}
+
+func runComp(args []string) error {
+	fs := flag.NewFlagSet("comp", flag.ContinueOnError)
+	rosterPath := fs.String("roster", "", "roster JSON file (champions with cost and traits)")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, traits, abilities, items)")
+	level := fs.Int("level", 8, "player level (team size)")
+	must := fs.String("must", "", "comma-separated champions every composition includes")
+	costCap := fs.Int("cap", 0, "max summed champion cost (0 = none)")
+	top := fs.Int("top", 5, "compositions shown")
+	unique := fs.Bool("unique", false, "count single-champion traits in the score")
+	emblems := fs.String("emblems", "", "comma-separated traits granted by emblems, one per emblem")
+	reference := fs.String("reference", "", "scenario file whose red board re-ranks compositions by simulation")
+	objective := fs.String("objective", "dps", "dps, ttk, survival or win_rate (team), with -reference")
+	runs := fs.Int("runs", 200, "Monte-Carlo runs per composition, with -reference")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *rosterPath == "" {
+		return fmt.Errorf("comp: -roster is required")
+	}
+
+	roster, traits, err := loadRoster(*rosterPath, *configDir)
+	if err != nil {
+		return err
+	}
+	obj, err := optimize.ParseObjective(*objective)
+	if err != nil {
+		return err
+	}
+	cfg := optimize.CompConfig{
+		Level: *level, CostCap: *costCap, Top: *top, CountUnique: *unique,
+		Objective: obj, MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs},
+	}
+	if *must != "" {
+		cfg.Must = strings.Split(*must, ",")
+	}
+	if *emblems != "" {
+		cfg.Emblems = strings.Split(*emblems, ",")
+	}
+	if *reference != "" {
+		ref, err := loadScenario(*reference, *configDir)
+		if err != nil {
+			return err
+		}
+		cfg.Reference, cfg.Sim = ref.Red, ref.Config
+	}
+	res, err := optimize.Compositions(roster, traits, cfg)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("level %d: %d nodes searched (exhaustive: %v)\n\n", res.Level, res.Nodes, res.Exhaustive)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "rank\tchampions\tcost\ttiers\ttraits\temblems\tsimulated")
+	for _, c := range res.Best {
+		var active []string
+		for _, t := range c.Traits {
+			if t.Tier > 0 {
+				active = append(active, fmt.Sprintf("%d %s", t.Count, t.Name))
+			}
+		}
+		held := "-"
+		if len(c.Emblems) > 0 {
+			var on []string
+			for _, e := range c.Emblems {
+				on = append(on, fmt.Sprintf("%s on %s", e.Trait, e.Unit))
+			}
+			held = strings.Join(on, ", ")
+		}
+		score := "-"
+		if c.Score != nil {
+			score = fmt.Sprintf("%.2f [%.2f, %.2f]", c.Score.Mean, c.Score.Low, c.Score.High)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\t%s\t%s\n", c.Rank, strings.Join(c.Units, ", "), c.Cost, c.Tiers, strings.Join(active, ", "), held, score)
+	}
+	return w.Flush()
+}
+
+func runPosition(args []string) error {
+	fs := flag.NewFlagSet("position", flag.ContinueOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file (blue is our board, red the fixed enemy)")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, abilities, items)")
+	objective := fs.String("objective", "win_rate", "win_rate, ttk, survival, or dps (with -carry)")
+	carry := fs.String("carry", "", "score this blue unit instead of the team")
+	iterations := fs.Int("iterations", 200, "layouts tried by the search")
+	temperature := fs.Float64("temperature", 0, "initial annealing temperature (0: hill climbing)")
+	seed := fs.Uint64("seed", 1, "search seed")
+	runs := fs.Int("runs", 200, "Monte-Carlo runs per layout")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioPath == "" {
+		return fmt.Errorf("position: -scenario is required")
+	}
+
+	s, err := loadScenario(*scenarioPath, *configDir)
+	if err != nil {
+		return err
+	}
+	obj, err := optimize.ParseObjective(*objective)
+	if err != nil {
+		return err
+	}
+	res, err := optimize.Positions(s, optimize.PositionConfig{
+		Objective: obj, Carry: *carry, Iterations: *iterations, Temperature: *temperature, Seed: *seed,
+		MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs},
+	})
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("%s: %d layouts simulated, %d moves accepted (%d runs each)\n\n", res.Objective, res.Evaluated, res.Accepted, *runs)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "layout\tmean\t95% CI\tmin\tmedian\tmax")
+	fmt.Fprintf(w, "start: %s\t%.3f\t[%.3f, %.3f]\t\t\t\n", res.Start, res.StartScore.Mean, res.StartScore.Low, res.StartScore.High)
+	d := res.Distribution
+	fmt.Fprintf(w, "best: %s\t%.3f\t[%.3f, %.3f]\t%.3f\t%.3f\t%.3f\n", res.Best, res.Score.Mean, res.Score.Low, res.Score.High, d.Min, d.Median, d.Max)
+	return w.Flush()
+}
+
+func runPowerUps(args []string) error {
+	fs := flag.NewFlagSet("powerups", flag.ContinueOnError)
+	scenarioPath := fs.String("scenario", "", "scenario JSON file")
+	configDir := fs.String("config", defaultConfigDir, "set config directory (roles, stages, abilities, items, power-ups)")
+	unit := fs.String("unit", "", "unit to compare power-ups for")
+	objective := fs.String("objective", "dps", "dps, ttk, survival or win_rate")
+	only := fs.String("only", "", "comma-separated power-ups to compare (default: all)")
+	runs := fs.Int("runs", 500, "Monte-Carlo runs per power-up")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioPath == "" || *unit == "" {
+		return fmt.Errorf("powerups: -scenario and -unit are required")
+	}
+
+	s, err := loadScenario(*scenarioPath, *configDir)
+	if err != nil {
+		return err
+	}
+	cfg, err := powerups.LoadPowerUps(filepath.Join(*configDir, "powerups.json"))
+	if err != nil {
+		return err
+	}
+	list := cfg.PowerUps
+	if *only != "" {
+		list = nil
+		for _, name := range strings.Split(*only, ",") {
+			p, ok := cfg.Lookup(name)
+			if !ok {
+				return fmt.Errorf("powerups: unknown power-up %q", name)
+			}
+			list = append(list, p)
+		}
+	}
+	obj, err := optimize.ParseObjective(*objective)
+	if err != nil {
+		return err
+	}
+	res, err := optimize.ComparePowerUps(s, *unit, list, optimize.PowerUpConfig{
+		Objective: obj, MonteCarloConfig: sim.MonteCarloConfig{Runs: *runs},
+	})
+	if err != nil {
+		return err
+	}
 
+	if *asJSON {
+		return json.MarshalWrite(os.Stdout, res, json.Deterministic(true))
+	}
+	fmt.Printf("%s: power-ups by %s (%d runs each, baseline %.2f)\n\n", res.Unit, res.Objective, *runs, res.Baseline.Mean)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "rank\tpower-up\tmean\t95% CI\tdelta")
+	for _, p := range res.Ranked {
+		fmt.Fprintf(w, "%d\t%s\t%.2f\t[%.2f, %.2f]\t%+.2f\n", p.Rank, p.PowerUp, p.Score.Mean, p.Score.Low, p.Score.High, p.Delta)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, sk := range res.Ineligible {
+		fmt.Printf("ineligible: %s (%s)\n", sk.PowerUp, sk.Reason)
+	}
+	return nil
 }
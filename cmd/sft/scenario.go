@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// scenarioFile is the on-disk scenario format (see internal/config/set15/scenarios).
+type scenarioFile struct {
+	Name    string     `json:"name"`
+	Seed    uint64     `json:"seed"`
+	MaxTime float64    `json:"max_time"`
+	Stage   int        `json:"stage"`
+	Round   int        `json:"round"`
+	Blue    []unitSpec `json:"blue"`
+	Red     []unitSpec `json:"red"`
+
+	BlueAugments []string `json:"blue_augments"` // augment names (augments.json)
+	RedAugments  []string `json:"red_augments"`
+}
+
+// unitSpec places one champion. Stats keys are stat paths or aliases
+// ("AD", "attack_speed", "offense.range"). Cost and traits are only needed
+// by composition searches.
+type unitSpec struct {
+	Name    string             `json:"name"`
+	Role    string             `json:"role"`
+	Star    int                `json:"star"`
+	Cost    int                `json:"cost"`
+	Traits  []string           `json:"traits"`
+	Pos     sim.Hex            `json:"pos"`
+	Stats   map[string]float64 `json:"stats"`
+	Items   []string           `json:"items"`
+	PowerUp string             `json:"power_up"` // power-up name (powerups.json)
+}
+
+// rosterFile is the on-disk champion pool of a composition search.
+type rosterFile struct {
+	Name      string     `json:"name"`
+	Stage     int        `json:"stage"`
+	Round     int        `json:"round"`
+	Champions []unitSpec `json:"champions"`
+}
+
+// setConfig is the set data units are built from.
+type setConfig struct {
+	roles   units.RolesLoader
+	stages  units.StagesLoader
+	traits  units.TraitsLoader
+	spells  abilities.AbilitiesLoader
+	catalog items.ItemsLoader
+	augs    augments.AugmentsLoader
+	powers  powerups.PowerUpsLoader
+}
+
+// loadSetConfig loads every config of the set in dir and validates it, so
+// commands never run on a catalog with unchecked entries.
+func loadSetConfig(dir string) (setConfig, error) {
+	var c setConfig
+	var err error
+	if c.roles, err = units.LoadRoles(filepath.Join(dir, "roles.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := units.ValidateRolesConfig(c.roles); err != nil {
+		return setConfig{}, err
+	}
+	if c.stages, err = units.LoadStages(filepath.Join(dir, "stages.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := units.ValidateStagesConfig(c.stages); err != nil {
+		return setConfig{}, err
+	}
+	if c.traits, err = units.LoadTraits(filepath.Join(dir, "traits.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := units.ValidateTraitsConfig(c.traits); err != nil {
+		return setConfig{}, err
+	}
+	if c.spells, err = abilities.LoadAbilities(filepath.Join(dir, "abilities.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := abilities.ValidateAbilitiesConfig(c.spells); err != nil {
+		return setConfig{}, err
+	}
+	if c.catalog, err = items.LoadItems(filepath.Join(dir, "items.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := items.ValidateItemsConfig(c.catalog); err != nil {
+		return setConfig{}, err
+	}
+	if err := items.ValidateEmblems(c.catalog, c.traits); err != nil {
+		return setConfig{}, err
+	}
+	if c.augs, err = augments.LoadAugments(filepath.Join(dir, "augments.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := augments.ValidateAugmentsConfig(c.augs, c.catalog, c.traits); err != nil {
+		return setConfig{}, err
+	}
+	if err := c.augs.ResolveItems(c.catalog); err != nil {
+		return setConfig{}, err
+	}
+	if c.powers, err = powerups.LoadPowerUps(filepath.Join(dir, "powerups.json")); err != nil {
+		return setConfig{}, err
+	}
+	if err := powerups.ValidatePowerUpsConfig(c.powers, c.roles); err != nil {
+		return setConfig{}, err
+	}
+	return c, nil
+}
+
+// factory returns a unit factory for one file: deterministic IDs from
+// (name, seed), traits checked against the catalog, stats at the game stage.
+func (c setConfig) factory(name string, seed uint64, game units.GameContext) units.UnitFactory {
+	return units.NewUnitFactory(c.roles,
+		units.WithIDGenerator(units.NewDeterministicIDs(name, int64(seed))),
+		units.WithTraits(c.traits),
+		units.WithGameContext(game, c.stages),
+	)
+}
+
+// placement builds the champion of spec in board slot.
+func (c setConfig) placement(f units.UnitFactory, slot int, spec unitSpec) (sim.Placement, error) {
+	opt, err := statOverrides(spec.Stats)
+	if err != nil {
+		return sim.Placement{}, fmt.Errorf("%s: %w", spec.Name, err)
+	}
+	u, err := f.BuildAt(slot, spec.Name, spec.Cost, spec.Traits, []string{spec.Role}, opt)
+	if err != nil {
+		return sim.Placement{}, err
+	}
+	p := sim.Placement{Unit: u, Pos: spec.Pos, Star: spec.Star}
+	if a, ok := c.spells.For(spec.Name); ok {
+		p.Ability = &a
+	}
+	for _, name := range spec.Items {
+		it, ok := c.catalog.Lookup(name)
+		if !ok {
+			return sim.Placement{}, fmt.Errorf("%s: unknown item %q", spec.Name, name)
+		}
+		p.Items = append(p.Items, it)
+	}
+	if spec.PowerUp != "" {
+		pu, ok := c.powers.Lookup(spec.PowerUp)
+		if !ok {
+			return sim.Placement{}, fmt.Errorf("%s: unknown power-up %q", spec.Name, spec.PowerUp)
+		}
+		p.PowerUp = &pu
+	}
+	return p, nil
+}
+
+// augments resolves augment names.
+func (c setConfig) augments(names []string) ([]augments.Augment, error) {
+	out := make([]augments.Augment, 0, len(names))
+	for _, n := range names {
+		a, ok := c.augs.Lookup(n)
+		if !ok {
+			return nil, fmt.Errorf("unknown augment %q", n)
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// loadScenario reads a scenario file and builds its units from the set config
+// in configDir (roles, stages, traits, abilities, items, augments and
+// power-ups).
+func loadScenario(path, configDir string) (sim.Scenario, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return sim.Scenario{}, fmt.Errorf("read scenario: %w", err)
+	}
+	var f scenarioFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return sim.Scenario{}, fmt.Errorf("parse scenario: %w", err)
+	}
+	set, err := loadSetConfig(configDir)
+	if err != nil {
+		return sim.Scenario{}, err
+	}
+
+	game := units.GameContext{Stage: f.Stage, Round: f.Round}
+	factory := set.factory(f.Name, f.Seed, game)
+	s := sim.Scenario{Name: f.Name, Config: sim.Config{Seed: f.Seed, MaxTime: f.MaxTime, Game: game}}
+	slot := 0
+	build := func(specs []unitSpec) (sim.Board, error) {
+		var board sim.Board
+		for _, spec := range specs {
+			p, err := set.placement(factory, slot, spec)
+			if err != nil {
+				return nil, err
+			}
+			slot++
+			board = append(board, p)
+		}
+		return board, nil
+	}
+	if s.Blue, err = build(f.Blue); err != nil {
+		return sim.Scenario{}, err
+	}
+	if s.Red, err = build(f.Red); err != nil {
+		return sim.Scenario{}, err
+	}
+	if s.Augments[sim.TeamBlue], err = set.augments(f.BlueAugments); err != nil {
+		return sim.Scenario{}, fmt.Errorf("blue_augments: %w", err)
+	}
+	if s.Augments[sim.TeamRed], err = set.augments(f.RedAugments); err != nil {
+		return sim.Scenario{}, fmt.Errorf("red_augments: %w", err)
+	}
+	return s, s.Validate()
+}
+
+// loadRoster reads a roster file and builds its champions like loadScenario.
+func loadRoster(path, configDir string) ([]sim.Placement, units.TraitsLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, units.TraitsLoader{}, fmt.Errorf("read roster: %w", err)
+	}
+	var f rosterFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, units.TraitsLoader{}, fmt.Errorf("parse roster: %w", err)
+	}
+	set, err := loadSetConfig(configDir)
+	if err != nil {
+		return nil, units.TraitsLoader{}, err
+	}
+	factory := set.factory(f.Name, 0, units.GameContext{Stage: f.Stage, Round: f.Round})
+	roster := make([]sim.Placement, 0, len(f.Champions))
+	for i, spec := range f.Champions {
+		p, err := set.placement(factory, i, spec)
+		if err != nil {
+			return nil, units.TraitsLoader{}, err
+		}
+		roster = append(roster, p)
+	}
+	return roster, set.traits, nil
+}
+
+// statOverrides turns a {path-or-alias: value} map into a stats option.
+func statOverrides(m map[string]float64) (units.Option, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	paths := make([]string, len(keys))
+	for i, k := range keys {
+		p, ok := units.ResolveStatPath(k)
+		if !ok {
+			return nil, fmt.Errorf("unknown stat %q", k)
+		}
+		paths[i] = p
+	}
+	return func(s *units.Stats) error {
+		for i, k := range keys {
+			s.SetValue(paths[i], m[k])
+		}
+		return nil
+	}, nil
+}
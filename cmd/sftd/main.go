@@ -1,29 +1,58 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 
 	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
 )
 
-const rolesPath = "internal/config/set15/roles.json"
+const (
+	rolesPath  = "internal/config/set15/roles.json"
+	traitsPath = "internal/config/set15/traits.json"
+	stagesPath = "internal/config/set15/stages.json"
+)
 
 func main() {
+	var game units.GameContext
+	flag.IntVar(&game.Stage, "stage", 0, "game stage 1-7 for stage-scaled stats (0 = none)")
+	flag.IntVar(&game.Round, "round", 1, "round within the stage")
+	flag.IntVar(&game.Level, "level", 0, "player level 1-10")
+	flag.Parse()
+	if err := game.Validate(); err != nil {
+		panic(err)
+	}
+
 	// 1) Load config Role (Source of truth)
 	cfg, err := units.LoadRoles(rolesPath)
 	if err != nil {
 		panic(fmt.Errorf("failed to load roles from %s: %w", rolesPath, err))
 	}
+	traits, err := units.LoadTraits(traitsPath)
+	if err != nil {
+		panic(fmt.Errorf("failed to load traits from %s: %w", traitsPath, err))
+	}
+	if err := units.ValidateTraitsConfig(traits); err != nil {
+		panic(err)
+	}
+	stages, err := units.LoadStages(stagesPath)
+	if err != nil {
+		panic(fmt.Errorf("failed to load stages from %s: %w", stagesPath, err))
+	}
+	if err := units.ValidateStagesConfig(stages); err != nil {
+		panic(err)
+	}
 	// 1.1) Activate Strict Mode
 	cfg.Strict = true
 
+	factory := units.NewUnitFactory(cfg, units.WithTraits(traits), units.WithGameContext(game, stages))
+
 	// 2) Build an Unit
-	u, err := units.BuildUnit(
+	u, err := factory.Build(
 		"Garen",
-		1,                               // Cost
-		[]string{"Warlord", "Vanguard"}, // Traits
-		[]string{"Attack Tank"},         // Roles
-		cfg,
+		1,                                      // Cost
+		[]string{"Battle Academia", "Bastion"}, // Traits
+		[]string{"Attack Tank"},                // Roles
 		// Explicit Overrides
 		units.WithHP(650),
 		units.WithArmor(35),
@@ -41,6 +70,7 @@ func main() {
 	}
 
 	// 3) Display
+	fmt.Printf("\nStage: %s\n", game)
 	printUnit(&u)
 }
 
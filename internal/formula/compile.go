@@ -0,0 +1,397 @@
+// Package formula is a small, sandboxed expression language for config values,
+// e.g. "[180,270,400][star] * AP/100 + 0.5*AD".
+//
+// Expressions are parsed and type-checked once by Compile and evaluated many
+// times by Eval. The language has numbers, list literals indexed by a number
+// (1-based, clamped, so [a,b,c][star] picks the star's value), arithmetic
+// (+ - * / %), comparisons (< <= > >= == != yield 1 or 0), variables declared
+// by an Env, and a fixed set of pure functions. There are no loops,
+// assignments or side effects, and division by zero yields 0. A constant
+// subexpression that overflows is a compile error; at run time an overflowing
+// result saturates to ±math.MaxFloat64 (NaN reads as 0), so evaluation always
+// terminates with a finite result.
+package formula
+
+import (
+	"math"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/suggest"
+)
+
+const (
+	maxSourceLen = 2048
+	maxDepth     = 64
+)
+
+type numFn func(vars []float64) float64
+
+// operand is a type-checked subexpression: a number or a list literal.
+type operand struct {
+	list    []operand // non-nil → list literal
+	num     numFn
+	isKnown bool // compile-time constant
+	val     float64
+	pos     int
+}
+
+// Expr is a compiled, type-checked expression.
+type Expr struct {
+	src  string
+	eval numFn
+}
+
+// String returns the source the expression was compiled from.
+func (x *Expr) String() string { return x.src }
+
+// Eval evaluates the expression; vars is indexed by Env slot and missing
+// slots read as 0. An overflow saturates to ±math.MaxFloat64; NaN is 0.
+func (x *Expr) Eval(vars []float64) float64 {
+	v := x.eval(vars)
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case math.IsInf(v, 0):
+		return math.Copysign(math.MaxFloat64, v)
+	}
+	return v
+}
+
+// Compile parses and type-checks src against env.
+func Compile(src string, env *Env) (*Expr, error) {
+	if len(src) > maxSourceLen {
+		return nil, errAt(0, "expression too long (%d > %d bytes)", len(src), maxSourceLen)
+	}
+	if strings.TrimSpace(src) == "" {
+		return nil, errAt(0, "empty expression")
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks, env: env}
+	op, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tEOF {
+		return nil, errAt(t.pos, "unexpected %q after end of expression", t.text)
+	}
+	if op.list != nil {
+		return nil, errAt(op.pos, "expression yields a list; index it, e.g. [...][star]")
+	}
+	return &Expr{src: src, eval: op.num}, nil
+}
+
+type parser struct {
+	toks  []token
+	i     int
+	env   *Env
+	depth int
+}
+
+func (p *parser) peek() token { return p.toks[p.i] }
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		if t.kind == tEOF {
+			return t, errAt(t.pos, "expected %s, got end of expression", what)
+		}
+		return t, errAt(t.pos, "expected %s, got %q", what, t.text)
+	}
+	return t, nil
+}
+
+var binaryPrec = map[string]int{
+	"<": 1, "<=": 1, ">": 1, ">=": 1, "==": 1, "!=": 1,
+	"+": 2, "-": 2,
+	"*": 3, "/": 3, "%": 3,
+}
+
+// expr parses a binary expression by precedence climbing (all operators left-associative).
+func (p *parser) expr(minPrec int) (operand, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return operand{}, errAt(p.peek().pos, "expression nested too deeply (max %d)", maxDepth)
+	}
+
+	lhs, err := p.unary()
+	if err != nil {
+		return operand{}, err
+	}
+	for {
+		t := p.peek()
+		prec, ok := binaryPrec[t.text]
+		if t.kind != tOp || !ok || prec < minPrec {
+			return lhs, nil
+		}
+		p.next()
+		rhs, err := p.expr(prec + 1)
+		if err != nil {
+			return operand{}, err
+		}
+		if lhs, err = binary(t, lhs, rhs); err != nil {
+			return operand{}, err
+		}
+	}
+}
+
+func (p *parser) unary() (operand, error) {
+	t := p.peek()
+	if t.kind == tOp && (t.text == "-" || t.text == "+") {
+		p.next()
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxDepth {
+			return operand{}, errAt(t.pos, "expression nested too deeply (max %d)", maxDepth)
+		}
+		x, err := p.unary()
+		if err != nil {
+			return operand{}, err
+		}
+		if err := wantNum(x, "operand of unary "+t.text); err != nil {
+			return operand{}, err
+		}
+		if t.text == "+" {
+			return x, nil
+		}
+		return fold(t.pos, []operand{x}, func(v []float64) float64 { return -v[0] },
+			func(fns ...numFn) numFn {
+				f := fns[0]
+				return func(vars []float64) float64 { return -f(vars) }
+			})
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (operand, error) {
+	x, err := p.primary()
+	if err != nil {
+		return operand{}, err
+	}
+	for p.peek().kind == tLBrack {
+		lb := p.next()
+		if x.list == nil {
+			return operand{}, errAt(lb.pos, "cannot index a number; only list literals can be indexed")
+		}
+		idx, err := p.expr(0)
+		if err != nil {
+			return operand{}, err
+		}
+		if err := wantNum(idx, "list index"); err != nil {
+			return operand{}, err
+		}
+		if _, err := p.expect(tRBrack, `"]"`); err != nil {
+			return operand{}, err
+		}
+		if x, err = index(x, idx); err != nil {
+			return operand{}, err
+		}
+	}
+	return x, nil
+}
+
+func (p *parser) primary() (operand, error) {
+	t := p.next()
+	switch t.kind {
+	case tNum:
+		return constant(t.pos, t.num), nil
+
+	case tLParen:
+		x, err := p.expr(0)
+		if err != nil {
+			return operand{}, err
+		}
+		if _, err := p.expect(tRParen, `")"`); err != nil {
+			return operand{}, err
+		}
+		return x, nil
+
+	case tLBrack:
+		var elems []operand
+		for {
+			e, err := p.expr(0)
+			if err != nil {
+				return operand{}, err
+			}
+			if err := wantNum(e, "list element"); err != nil {
+				return operand{}, err
+			}
+			elems = append(elems, e)
+			sep := p.next()
+			if sep.kind == tRBrack {
+				break
+			}
+			if sep.kind != tComma {
+				return operand{}, errAt(sep.pos, `expected "," or "]" in list, got %q`, sep.text)
+			}
+		}
+		return operand{list: elems, pos: t.pos}, nil
+
+	case tIdent:
+		if p.peek().kind == tLParen {
+			return p.call(t)
+		}
+		slot, ok := p.env.Slot(t.text)
+		if !ok {
+			hint, _ := suggest.Closest(t.text, p.env.Names())
+			return operand{}, errAt(t.pos, "unknown variable %q%s", t.text, suggest.DidYouMean(hint))
+		}
+		return operand{pos: t.pos, num: func(vars []float64) float64 {
+			if slot < len(vars) {
+				return vars[slot]
+			}
+			return 0
+		}}, nil
+
+	case tEOF:
+		return operand{}, errAt(t.pos, "unexpected end of expression")
+	default:
+		return operand{}, errAt(t.pos, "unexpected %q", t.text)
+	}
+}
+
+func (p *parser) call(name token) (operand, error) {
+	spec, ok := functions[strings.ToLower(name.text)]
+	if !ok {
+		hint, _ := suggest.Closest(name.text, functionNames())
+		return operand{}, errAt(name.pos, "unknown function %q%s", name.text, suggest.DidYouMean(hint))
+	}
+	p.next() // (
+	var args []operand
+	if p.peek().kind != tRParen {
+		for {
+			a, err := p.expr(0)
+			if err != nil {
+				return operand{}, err
+			}
+			if err := wantNum(a, "argument of "+name.text); err != nil {
+				return operand{}, err
+			}
+			args = append(args, a)
+			if p.peek().kind != tComma {
+				break
+			}
+			p.next()
+		}
+	}
+	if _, err := p.expect(tRParen, `")"`); err != nil {
+		return operand{}, err
+	}
+	if len(args) < spec.minArgs || (spec.maxArgs >= 0 && len(args) > spec.maxArgs) {
+		return operand{}, errAt(name.pos, "%s expects %s, got %d", name.text, spec.arity(), len(args))
+	}
+	return spec.build(name.pos, args)
+}
+
+func wantNum(x operand, what string) error {
+	if x.list != nil {
+		return errAt(x.pos, "%s must be a number, got a list", what)
+	}
+	return nil
+}
+
+func constant(pos int, v float64) operand {
+	return operand{pos: pos, isKnown: true, val: v, num: func([]float64) float64 { return v }}
+}
+
+// fold returns a constant when every arg is known, otherwise the runtime
+// closure. A constant that is not finite is an error.
+func fold(pos int, args []operand, calc func([]float64) float64, build func(...numFn) numFn) (operand, error) {
+	known := true
+	vals := make([]float64, len(args))
+	fns := make([]numFn, len(args))
+	for i, a := range args {
+		known = known && a.isKnown
+		vals[i] = a.val
+		fns[i] = a.num
+	}
+	if known {
+		v := calc(vals)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return operand{}, errAt(pos, "constant overflows (%v)", v)
+		}
+		return constant(pos, v), nil
+	}
+	return operand{pos: pos, num: build(fns...)}, nil
+}
+
+func binary(op token, l, r operand) (operand, error) {
+	if err := wantNum(l, "left operand of "+op.text); err != nil {
+		return operand{}, err
+	}
+	if err := wantNum(r, "right operand of "+op.text); err != nil {
+		return operand{}, err
+	}
+	f := binaryOps[op.text]
+	return fold(l.pos, []operand{l, r},
+		func(v []float64) float64 { return f(v[0], v[1]) },
+		func(fns ...numFn) numFn {
+			a, b := fns[0], fns[1]
+			return func(vars []float64) float64 { return f(a(vars), b(vars)) }
+		})
+}
+
+var binaryOps = map[string]func(a, b float64) float64{
+	"+": func(a, b float64) float64 { return a + b },
+	"-": func(a, b float64) float64 { return a - b },
+	"*": func(a, b float64) float64 { return a * b },
+	"/": func(a, b float64) float64 {
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	},
+	"%": func(a, b float64) float64 {
+		if b == 0 {
+			return 0
+		}
+		return math.Mod(a, b)
+	},
+	"<":  func(a, b float64) float64 { return boolNum(a < b) },
+	"<=": func(a, b float64) float64 { return boolNum(a <= b) },
+	">":  func(a, b float64) float64 { return boolNum(a > b) },
+	">=": func(a, b float64) float64 { return boolNum(a >= b) },
+	"==": func(a, b float64) float64 { return boolNum(a == b) },
+	"!=": func(a, b float64) float64 { return boolNum(a != b) },
+}
+
+func boolNum(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// index selects list[i] with 1-based, clamped indexing (rounded to nearest).
+func index(list, idx operand) (operand, error) {
+	elems := list.list
+	if idx.isKnown {
+		i := int(math.Round(idx.val))
+		if i < 1 || i > len(elems) {
+			return operand{}, errAt(idx.pos, "index %v out of range [1,%d]", idx.val, len(elems))
+		}
+		picked := elems[i-1]
+		picked.pos = list.pos
+		return picked, nil
+	}
+	fns := make([]numFn, len(elems))
+	for i, e := range elems {
+		fns[i] = e.num
+	}
+	at := idx.num
+	return operand{pos: list.pos, num: func(vars []float64) float64 {
+		i := int(math.Round(at(vars)))
+		i = max(1, min(i, len(fns)))
+		return fns[i-1](vars)
+	}}, nil
+}
@@ -0,0 +1,123 @@
+package formula
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func testEnv() *Env {
+	e := NewEnv("star", "offense.ability_power", "offense.attack_damage", "target.missing_hp")
+	e.Alias("AP", "offense.ability_power")
+	e.Alias("AD", "offense.attack_damage")
+	e.SetResolver(func(name string) (string, bool) {
+		if strings.EqualFold(name, "ability_power") {
+			return "offense.ability_power", true
+		}
+		return "", false
+	})
+	return e
+}
+
+func TestCompile_EvalExamples(t *testing.T) {
+	t.Parallel()
+	env := testEnv()
+	// star, AP, AD, missing HP
+	vars := []float64{2, 150, 80, 400}
+	cases := map[string]float64{
+		"[180,270,400][star] * AP/100 + 0.5*AD": 270*1.5 + 40,
+		"1 + 2 * 3":                             7,
+		"(1 + 2) * 3":                           9,
+		"10 - 4 - 3":                            3,
+		"-AD + +5":                              -75,
+		"2.5e1 % 7":                             4,
+		"max(AD, AP, 10) - min(1, 2)":           149,
+		"clamp(target.missing_hp, 0, 100)":      100,
+		"if(star >= 2, 1, 0)":                   1,
+		"if(star == 3, 10, 20)":                 20,
+		"AD / 0":                                0,
+		"floor(2.7) + ceil(2.1) + round(2.5) + abs(-1)": 9,
+		"[1,2,3][star + 5]":                             3, // clamped
+		"offense.ability_power":                         150,
+	}
+	for src, want := range cases {
+		x, err := Compile(src, env)
+		if err != nil {
+			t.Fatalf("%q: compile: %v", src, err)
+		}
+		if got := x.Eval(vars); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("%q = %v want %v", src, got, want)
+		}
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	t.Parallel()
+	env := testEnv()
+	cases := map[string]string{
+		"":                        "empty expression",
+		"APP * 2":                 `col 1: unknown variable "APP" (did you mean "AP"?)`,
+		"1 + starr":               `col 5: unknown variable "starr" (did you mean "star"?)`,
+		"mx(1, 2)":                `unknown function "mx" (did you mean "max"?)`,
+		"clamp(1, 2)":             "clamp expects 3 arguments, got 2",
+		"[1,2,3]":                 "yields a list",
+		"[1,2,3] + 1":             "must be a number, got a list",
+		"AD[1]":                   "cannot index a number",
+		"[1,2][3]":                "index 3 out of range [1,2]",
+		"[[1,2]][1]":              "list element must be a number",
+		"1 +":                     "unexpected end of expression",
+		"(1 + 2":                  `expected ")"`,
+		"1 2":                     `unexpected "2"`,
+		"AD = 2":                  `unexpected "=" (did you mean "=="?)`,
+		"AD $ 2":                  "unexpected character",
+		"1e308 * 10":              "col 1: constant overflows",
+		"AD + max(1e308, 1) * 10": "col 6: constant overflows",
+		strings.Repeat("(", 70) + "1" + strings.Repeat(")", 70): "nested too deeply",
+	}
+	for src, want := range cases {
+		_, err := Compile(src, env)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%q: expected error containing %q, got %v", src, want, err)
+		}
+	}
+}
+
+func TestCompile_ConstantFolding(t *testing.T) {
+	t.Parallel()
+	x, err := Compile("[10, 20, 30][1 + 1] * 2", NewEnv())
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if got := x.Eval(nil); got != 40 {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestEval_MissingVarsReadZero(t *testing.T) {
+	t.Parallel()
+	x, err := Compile("AD + 1", testEnv())
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if got := x.Eval(nil); got != 1 {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestEval_OverflowSaturates(t *testing.T) {
+	t.Parallel()
+	cases := map[string]float64{
+		"AD * 1e308 * 10":                                math.MaxFloat64,
+		"-AD * 1e308 * 10 + AP":                          -math.MaxFloat64,
+		"AD * 1e308 - AD * 1e308 * 10 + AD * 1e308 * 10": 0, // Inf - Inf is NaN
+	}
+	for src, want := range cases {
+		x, err := Compile(src, testEnv())
+		if err != nil {
+			t.Fatalf("%s: compile: %v", src, err)
+		}
+		if got := x.Eval([]float64{1, 0, 5}); got != want {
+			t.Fatalf("%s = %v, want %v", src, got, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package formula
+
+import "sort"
+
+// Env declares the variables an expression may reference. Each variable gets a
+// slot; Eval reads its value from vars[slot]. Build one Env per config domain
+// (abilities, items, ...) and share it across compiled expressions.
+type Env struct {
+	slots   map[string]int
+	names   []string
+	aliases []string
+	resolve func(string) (string, bool)
+}
+
+// NewEnv declares names in order (slot i = names[i]).
+func NewEnv(names ...string) *Env {
+	e := &Env{slots: make(map[string]int, len(names))}
+	for _, n := range names {
+		e.Define(n)
+	}
+	return e
+}
+
+// Define declares a variable and returns its slot (idempotent).
+func (e *Env) Define(name string) int {
+	if s, ok := e.slots[name]; ok {
+		return s
+	}
+	s := len(e.names)
+	e.slots[name] = s
+	e.names = append(e.names, name)
+	return s
+}
+
+// Alias makes alias refer to the already declared name; aliases are also
+// offered as "did you mean" hints. It reports false if name is undeclared.
+func (e *Env) Alias(alias, name string) bool {
+	s, ok := e.slots[name]
+	if !ok {
+		return false
+	}
+	if _, taken := e.slots[alias]; !taken {
+		e.slots[alias] = s
+		e.aliases = append(e.aliases, alias)
+	}
+	return true
+}
+
+// SetResolver installs a fallback that maps unknown spellings (aliases, other
+// casings) onto declared names before a lookup fails.
+func (e *Env) SetResolver(f func(string) (string, bool)) { e.resolve = f }
+
+// Slot returns the slot of name, trying the resolver for unknown spellings.
+func (e *Env) Slot(name string) (int, bool) {
+	if s, ok := e.slots[name]; ok {
+		return s, true
+	}
+	if e.resolve != nil {
+		if canon, ok := e.resolve(name); ok {
+			s, ok := e.slots[canon]
+			return s, ok
+		}
+	}
+	return 0, false
+}
+
+// Len is the number of declared variables (the minimum length of Eval's vars).
+func (e *Env) Len() int { return len(e.names) }
+
+// Names returns declared names and aliases sorted alphabetically.
+func (e *Env) Names() []string {
+	out := append(append([]string(nil), e.names...), e.aliases...)
+	sort.Strings(out)
+	return out
+}
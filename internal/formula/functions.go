@@ -0,0 +1,82 @@
+package formula
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// funcSpec is a built-in pure function. maxArgs < 0 means variadic.
+type funcSpec struct {
+	minArgs, maxArgs int
+	calc             func(args []float64) float64
+}
+
+func (s funcSpec) arity() string {
+	switch {
+	case s.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", s.minArgs)
+	case s.minArgs == s.maxArgs && s.minArgs == 1:
+		return "1 argument"
+	case s.minArgs == s.maxArgs:
+		return fmt.Sprintf("%d arguments", s.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", s.minArgs, s.maxArgs)
+	}
+}
+
+// build folds constant calls and otherwise evaluates args into a per-call buffer.
+func (s funcSpec) build(pos int, args []operand) (operand, error) {
+	return fold(pos, args, s.calc, func(fns ...numFn) numFn {
+		return func(vars []float64) float64 {
+			var buf [8]float64
+			vals := buf[:0]
+			for _, f := range fns {
+				vals = append(vals, f(vars))
+			}
+			return s.calc(vals)
+		}
+	})
+}
+
+var functions = map[string]funcSpec{
+	"min":   {1, -1, func(a []float64) float64 { return minOf(a) }},
+	"max":   {1, -1, func(a []float64) float64 { return maxOf(a) }},
+	"clamp": {3, 3, func(a []float64) float64 { return math.Max(a[1], math.Min(a[0], a[2])) }},
+	"abs":   {1, 1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"floor": {1, 1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, 1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"round": {1, 1, func(a []float64) float64 { return math.Round(a[0]) }},
+	// if(cond, then, else): cond != 0 selects then.
+	"if": {3, 3, func(a []float64) float64 {
+		if a[0] != 0 {
+			return a[1]
+		}
+		return a[2]
+	}},
+}
+
+func functionNames() []string {
+	out := make([]string, 0, len(functions))
+	for n := range functions {
+		out = append(out, n)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func minOf(a []float64) float64 {
+	m := a[0]
+	for _, v := range a[1:] {
+		m = math.Min(m, v)
+	}
+	return m
+}
+
+func maxOf(a []float64) float64 {
+	m := a[0]
+	for _, v := range a[1:] {
+		m = math.Max(m, v)
+	}
+	return m
+}
@@ -0,0 +1,121 @@
+package formula
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+type tokKind int
+
+const (
+	tEOF tokKind = iota
+	tNum
+	tIdent
+	tOp
+	tLParen
+	tRParen
+	tLBrack
+	tRBrack
+	tComma
+)
+
+type token struct {
+	kind tokKind
+	text string
+	num  float64
+	pos  int // byte offset in the source
+}
+
+// Error is a compile error positioned in the expression source.
+type Error struct {
+	Pos int // byte offset
+	Msg string
+}
+
+func (e *Error) Error() string { return fmt.Sprintf("col %d: %s", e.Pos+1, e.Msg) }
+
+func errAt(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func lex(src string) ([]token, error) {
+	var toks []token
+	rs := []rune(src)
+	// byte offsets for error columns
+	offs := make([]int, len(rs)+1)
+	for i, b := 0, 0; i < len(rs); i++ {
+		offs[i] = b
+		b += len(string(rs[i]))
+		offs[i+1] = b
+	}
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		pos := offs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.') {
+				j++
+			}
+			// exponent: 1e3, 2.5E-2
+			if j < len(rs) && (rs[j] == 'e' || rs[j] == 'E') {
+				k := j + 1
+				if k < len(rs) && (rs[k] == '+' || rs[k] == '-') {
+					k++
+				}
+				if k < len(rs) && unicode.IsDigit(rs[k]) {
+					for k < len(rs) && unicode.IsDigit(rs[k]) {
+						k++
+					}
+					j = k
+				}
+			}
+			text := string(rs[i:j])
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, errAt(pos, "invalid number %q", text)
+			}
+			toks = append(toks, token{kind: tNum, text: text, num: v, pos: pos})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]) || rs[j] == '_' ||
+				(rs[j] == '.' && j+1 < len(rs) && (unicode.IsLetter(rs[j+1]) || rs[j+1] == '_'))) {
+				j++
+			}
+			toks = append(toks, token{kind: tIdent, text: string(rs[i:j]), pos: pos})
+			i = j
+		default:
+			kind, text := tOp, string(r)
+			switch r {
+			case '(':
+				kind = tLParen
+			case ')':
+				kind = tRParen
+			case '[':
+				kind = tLBrack
+			case ']':
+				kind = tRBrack
+			case ',':
+				kind = tComma
+			case '+', '-', '*', '/', '%':
+			case '<', '>', '=', '!':
+				if i+1 < len(rs) && rs[i+1] == '=' {
+					text += "="
+				} else if r == '=' || r == '!' {
+					return nil, errAt(pos, "unexpected %q (did you mean %q?)", text, text+"=")
+				}
+			default:
+				return nil, errAt(pos, "unexpected character %q", text)
+			}
+			toks = append(toks, token{kind: kind, text: text, pos: pos})
+			i += len([]rune(text))
+		}
+	}
+	toks = append(toks, token{kind: tEOF, pos: len(src)})
+	return toks, nil
+}
@@ -0,0 +1,69 @@
+package abilities
+
+import (
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/formula"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Combat-context variables available to ability formulas, next to every caster
+// stat path ("offense.ability_power", bare "ability_power", or aliases like "AP").
+const (
+	VarStar            = "star"
+	VarStacks          = "stacks"
+	VarTargetHP        = "target.hp"
+	VarTargetMaxHP     = "target.max_hp"
+	VarTargetMissingHP = "target.missing_hp"
+)
+
+var (
+	formulaEnv       = newFormulaEnv()
+	formulaStatPaths = units.StatPaths()
+)
+
+func newFormulaEnv() *formula.Env {
+	env := formula.NewEnv(VarStar, VarStacks, VarTargetHP, VarTargetMaxHP, VarTargetMissingHP)
+	for _, p := range units.StatPaths() {
+		env.Define(p)
+	}
+	for alias, full := range units.StatAliases() {
+		env.Alias(alias, full)
+		env.Alias(strings.ToUpper(alias), full) // "AP", "AD" as written in tooltips
+	}
+	env.SetResolver(units.ResolveStatPath)
+	return env
+}
+
+// TargetState is what a formula can read about one target of a cast.
+type TargetState struct {
+	HP    float64
+	MaxHP float64
+}
+
+// formulaVars lays out the evaluation vector in formulaEnv slot order.
+func formulaVars(ctx CastContext, target TargetState) []float64 {
+	vars := make([]float64, formulaEnv.Len())
+	vars[0] = float64(ctx.Star)
+	vars[1] = ctx.Stacks
+	vars[2] = target.HP
+	vars[3] = target.MaxHP
+	vars[4] = max(0, target.MaxHP-target.HP)
+	for i, p := range formulaStatPaths {
+		vars[5+i], _ = ctx.Caster.Value(p)
+	}
+	return vars
+}
+
+// compileDamage compiles the damage formula once (no-op without one).
+func (a *Ability) compileDamage() error {
+	if a.Damage == "" || a.damageExpr != nil {
+		return nil
+	}
+	x, err := formula.Compile(a.Damage, formulaEnv)
+	if err != nil {
+		return err
+	}
+	a.damageExpr = x
+	return nil
+}
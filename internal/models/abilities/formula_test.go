@@ -0,0 +1,78 @@
+package abilities
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestResolveContext_Formula_UsesStatsAndCombatContext(t *testing.T) {
+	t.Parallel()
+	a := Ability{
+		Name:       "Execute",
+		DamageType: units.Physical,
+		Damage:     "[180,270,400][star] * AP/100 + 0.5*AD + 0.1*target.missing_hp + stacks",
+		Targeting:  TargetCurrent,
+		Targets:    2,
+	}
+	caster, err := units.NewStats(units.WithRange(1), units.WithAP(150), units.WithAD(80))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	c, err := ResolveContext(a, CastContext{
+		Star:    2,
+		Caster:  caster,
+		Stacks:  3,
+		Targets: []TargetState{{HP: 600, MaxHP: 1000}}, // second target missing → zero state
+	})
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	// 270×1.5 + 40 + 40 + 3 = 488; second target: 405 + 40 + 0 + 3 = 448
+	if got := c.Instances[0].Amount; got != 488 {
+		t.Fatalf("target 0: got %v want 488", got)
+	}
+	if got := c.Instances[1].Amount; got != 448 {
+		t.Fatalf("target 1: got %v want 448", got)
+	}
+}
+
+func TestValidate_FormulaErrorsAndBaseOptional(t *testing.T) {
+	t.Parallel()
+	a := Ability{Name: "X", DamageType: units.Magic, Damage: "APP * 2", Targeting: TargetCurrent, Targets: 1}
+	err := a.Validate()
+	if err == nil || !strings.Contains(err.Error(), `unknown variable "APP" (did you mean "AP"?)`) {
+		t.Fatalf("expected formula error with hint, got %v", err)
+	}
+	a.Damage = "ability_power * 2"
+	if err := a.Validate(); err != nil {
+		t.Fatalf("formula abilities do not need base: %v", err)
+	}
+}
+
+func TestLoadAbilities_FormulaErrorPointsAtConfigPath(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "abilities.json")
+	doc := `{"abilities": {"Syndra": {"name": "Dark Sphere", "damage_type": "magic",
+		"damage": "[270,405,610][star] * (AP + ", "targeting": "current_target", "targets": 1}}}`
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, err := LoadAbilities(path)
+	if err == nil || !strings.Contains(err.Error(), "abilities.Syndra.damage: col 29: unexpected end of expression") {
+		t.Fatalf("expected positioned config error, got %v", err)
+	}
+
+	doc = `{"abilities": {"Syndra": {"name": "Dark Sphere", "damage_type": "magic",
+		"damage": "AP + 1e308 * 10", "targeting": "current_target", "targets": 1}}}`
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, err = LoadAbilities(path)
+	if err == nil || !strings.Contains(err.Error(), "abilities.Syndra.damage: col 6: constant overflows") {
+		t.Fatalf("expected an overflow config error, got %v", err)
+	}
+}
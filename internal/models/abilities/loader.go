@@ -0,0 +1,72 @@
+package abilities
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// AbilitiesLoader holds per-champion ability definitions keyed by champion name.
+type AbilitiesLoader struct {
+	Abilities map[string]Ability `json:"abilities"`
+}
+
+func LoadAbilities(path string) (AbilitiesLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return AbilitiesLoader{}, fmt.Errorf("read abilities config: %w", err)
+	}
+	var cfg AbilitiesLoader
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return AbilitiesLoader{}, fmt.Errorf("parse abilities config: %w", err)
+	}
+	cfg.normalize()
+	if err := cfg.compile(); err != nil {
+		return AbilitiesLoader{}, fmt.Errorf("parse abilities config: %w", err)
+	}
+	return cfg, nil
+}
+
+// normalize canonicalizes case-insensitive enums ("Magic" → "magic"); invalid
+// values are kept as-is so validation can report them.
+func (c AbilitiesLoader) normalize() {
+	for champ, a := range c.Abilities {
+		if dt, err := units.ParseDamageType(string(a.DamageType)); err == nil {
+			a.DamageType = dt
+		}
+		a.Targeting = Targeting(strings.ToLower(strings.TrimSpace(string(a.Targeting))))
+		c.Abilities[champ] = a
+	}
+}
+
+// compile parses and type-checks every damage formula once, reporting the
+// config path of the first failure (e.g. "abilities.Syndra.damage: col 12: ...").
+func (c AbilitiesLoader) compile() error {
+	for _, champ := range slices.Sorted(maps.Keys(c.Abilities)) {
+		a := c.Abilities[champ]
+		if err := a.compileDamage(); err != nil {
+			return fmt.Errorf("abilities.%s.damage: %w", champ, err)
+		}
+		c.Abilities[champ] = a
+	}
+	return nil
+}
+
+// For returns the ability of a champion (exact name first, then case-insensitive).
+func (c AbilitiesLoader) For(champion string) (Ability, bool) {
+	if a, ok := c.Abilities[champion]; ok {
+		return a, true
+	}
+	for name, a := range c.Abilities {
+		if strings.EqualFold(name, strings.TrimSpace(champion)) {
+			return a, true
+		}
+	}
+	return Ability{}, false
+}
@@ -0,0 +1,42 @@
+package abilities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestLoadAbilities_Set15Config(t *testing.T) {
+	t.Parallel()
+	cfg, err := LoadAbilities("../../config/set15/abilities.json")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if err := ValidateAbilitiesConfig(cfg); err != nil {
+		t.Fatalf("set15 abilities invalid: %v", err)
+	}
+	a, ok := cfg.For("syndra")
+	if !ok || a.DamageType != units.Magic || len(a.Base) != MaxStar {
+		t.Fatalf("unexpected Syndra ability: %+v ok=%v", a, ok)
+	}
+}
+
+func TestValidateAbilitiesConfig_ReportsPaths(t *testing.T) {
+	t.Parallel()
+	cfg := AbilitiesLoader{Abilities: map[string]Ability{
+		"Foo": {Name: "X", DamageType: "fire", Base: []float64{1, 2, 3, 4}, Targeting: "everyone", Targets: 0, CastTime: -1},
+		"Bar": {Name: "Y", DamageType: "magic", Base: []float64{1}, Targeting: TargetSelf, Targets: 1,
+			Summon: &Summon{Stats: map[string]float64{"atack_damage": 10}, Lifetime: -1}},
+	}}
+	err := ValidateAbilitiesConfig(cfg)
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	for _, want := range []string{"Foo.damage_type", "Foo.base", "Foo.targeting", "Foo.targets", "Foo.cast_time",
+		"Bar.summon.name", "Bar.summon.lifetime", "Bar.summon.stats.atack_damage: unknown stat"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
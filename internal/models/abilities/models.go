@@ -0,0 +1,71 @@
+package abilities
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/formula"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Targeting selects who a cast hits. The engine resolves it against the board.
+type Targeting string
+
+const (
+	TargetCurrent  Targeting = "current_target" // the caster's attack target
+	TargetNearest  Targeting = "nearest"        // nearest enemies to the caster
+	TargetFarthest Targeting = "farthest"       // farthest enemies from the caster
+	TargetLowestHP Targeting = "lowest_hp"      // enemies with the lowest current HP
+	TargetSelf     Targeting = "self"           // the caster (self-buffs, heals)
+)
+
+var validTargeting = map[Targeting]struct{}{
+	TargetCurrent: {}, TargetNearest: {}, TargetFarthest: {}, TargetLowestHP: {}, TargetSelf: {},
+}
+
+// MaxStar is the highest champion star level.
+const MaxStar = 3
+
+// Ability is a champion's spell definition.
+// Damage per target = Base[star-1] + APRatio×AP + ADRatio×AD, then × (1 + DamageAmp).
+// A Damage formula, when set, replaces Base and the ratios (see package formula).
+type Ability struct {
+	Name       string           `json:"name"`
+	DamageType units.DamageType `json:"damage_type"` // physical | magic | true
+	Base       []float64        `json:"base"`        // per star level: [1★, 2★, 3★]
+	APRatio    float64          `json:"ap_ratio"`    // damage per point of ability power
+	ADRatio    float64          `json:"ad_ratio"`    // damage per point of attack damage
+	CastTime   float64          `json:"cast_time"`   // seconds the caster is locked while casting
+	Targeting  Targeting        `json:"targeting"`
+	Targets    int              `json:"targets"` // number of targets hit (>= 1)
+	Damage     string           `json:"damage"`  // optional formula, e.g. "[180,270,400][star] * AP/100"
+	Summon     *Summon          `json:"summon,omitempty"`
+
+	damageExpr *formula.Expr // compiled Damage, set at load
+}
+
+// Summon is a unit the spell spawns next to the caster when it lands. Its
+// damage counts as the caster's (see sim.Engine.Summon).
+type Summon struct {
+	Name         string             `json:"name"`
+	Stats        map[string]float64 `json:"stats"`              // over the default stats; stat paths or aliases
+	Traits       []string           `json:"traits,omitempty"`   // counted only with CountTraits
+	Lifetime     float64            `json:"lifetime,omitempty"` // seconds (0 → rest of combat)
+	Untargetable bool               `json:"untargetable,omitempty"`
+	CountTraits  bool               `json:"count_traits,omitempty"`
+}
+
+// UnitStats builds the summon's stats: defaults with Stats set on top.
+func (s Summon) UnitStats() (units.Stats, error) {
+	return units.NewStats(func(st *units.Stats) error {
+		for _, k := range slices.Sorted(maps.Keys(s.Stats)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return fmt.Errorf("unknown stat %q", k)
+			}
+			st.SetValue(path, s.Stats[k])
+		}
+		return nil
+	})
+}
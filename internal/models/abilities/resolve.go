@@ -0,0 +1,82 @@
+package abilities
+
+import (
+	"fmt"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// DamageInstance is one pre-mitigation hit produced by a cast.
+type DamageInstance struct {
+	Type   units.DamageType `json:"type"`
+	Amount float64          `json:"amount"`
+	Target int              `json:"target"` // index into the engine's resolved target list (0 = primary)
+}
+
+// Cast is a resolved ability use: what to hit, how long it takes, and how hard.
+type Cast struct {
+	Ability   string           `json:"ability"`
+	CastTime  float64          `json:"cast_time"`
+	Targeting Targeting        `json:"targeting"`
+	Instances []DamageInstance `json:"instances"`
+}
+
+// CastContext is the combat state a cast is resolved against.
+type CastContext struct {
+	Star    int
+	Caster  units.Stats
+	Stacks  float64       // ability/trait stack count, for formulas
+	Targets []TargetState // per target index; missing entries read as zero
+}
+
+// Resolve turns a cast of a at the given star level into damage instances,
+// one per target, using the caster's current AP/AD and DamageAmp.
+// Stars beyond the configured values reuse the last one.
+func Resolve(a Ability, star int, caster units.Stats) (Cast, error) {
+	return ResolveContext(a, CastContext{Star: star, Caster: caster})
+}
+
+// ResolveContext is Resolve with full combat context (stacks, target state)
+// for formula-based abilities; each target gets its own evaluation.
+func ResolveContext(a Ability, ctx CastContext) (Cast, error) {
+	if err := a.compileDamage(); err != nil { // no-op when compiled at load
+		return Cast{}, fmt.Errorf("ability %q: damage: %w", a.Name, err)
+	}
+	if err := a.Validate(); err != nil {
+		return Cast{}, err
+	}
+	if ctx.Star < 1 || ctx.Star > MaxStar {
+		return Cast{}, fmt.Errorf("ability %q: star must be in [1,%d], got %d", a.Name, MaxStar, ctx.Star)
+	}
+
+	c := Cast{
+		Ability:   a.Name,
+		CastTime:  a.CastTime,
+		Targeting: a.Targeting,
+		Instances: make([]DamageInstance, a.Targets),
+	}
+	for i := range c.Instances {
+		var target TargetState
+		if i < len(ctx.Targets) {
+			target = ctx.Targets[i]
+		}
+		c.Instances[i] = DamageInstance{Type: a.DamageType, Amount: a.rawDamage(ctx, target), Target: i}
+	}
+	return c, nil
+}
+
+// rawDamage is the pre-mitigation damage against one target, amp included.
+func (a Ability) rawDamage(ctx CastContext, target TargetState) float64 {
+	var amount float64
+	if a.damageExpr != nil {
+		amount = a.damageExpr.Eval(formulaVars(ctx, target))
+	} else {
+		base := a.Base[min(ctx.Star, len(a.Base))-1]
+		amount = base + a.APRatio*ctx.Caster.Offense.AP + a.ADRatio*ctx.Caster.Offense.AD
+	}
+	amount *= 1 + ctx.Caster.Offense.DamageAmp
+	if amount < 0 {
+		amount = 0 // heavy negative amp cannot heal the target
+	}
+	return amount
+}
@@ -0,0 +1,86 @@
+package abilities
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func testAbility() Ability {
+	return Ability{
+		Name:       "Test Bolt",
+		DamageType: units.Magic,
+		Base:       []float64{100, 150, 225},
+		APRatio:    1.0,
+		ADRatio:    0.5,
+		CastTime:   0.5,
+		Targeting:  TargetLowestHP,
+		Targets:    2,
+	}
+}
+
+func TestResolve_ScalesWithStarAPAndAD(t *testing.T) {
+	t.Parallel()
+	caster, err := units.NewStats(units.WithRange(1), units.WithAP(40), units.WithAD(60), units.WithDamageAmp(0.1))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	c, err := Resolve(testAbility(), 2, caster)
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	// (150 + 1.0×40 + 0.5×60) × 1.1 = 242
+	want := 242.0
+	if len(c.Instances) != 2 {
+		t.Fatalf("expected 2 instances, got %d", len(c.Instances))
+	}
+	for i, in := range c.Instances {
+		if in.Type != units.Magic || in.Target != i || in.Amount < want-1e-9 || in.Amount > want+1e-9 {
+			t.Fatalf("instance %d: got %+v want amount %v", i, in, want)
+		}
+	}
+	if c.CastTime != 0.5 || c.Targeting != TargetLowestHP || c.Ability != "Test Bolt" {
+		t.Fatalf("cast metadata not carried: %+v", c)
+	}
+}
+
+func TestResolve_ShortBaseReusesLastStar(t *testing.T) {
+	t.Parallel()
+	a := testAbility()
+	a.Base = []float64{100}
+	a.APRatio, a.ADRatio = 0, 0
+	c, err := Resolve(a, 3, units.Default())
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if c.Instances[0].Amount != 100 {
+		t.Fatalf("expected 100, got %v", c.Instances[0].Amount)
+	}
+}
+
+func TestResolve_Errors(t *testing.T) {
+	t.Parallel()
+	if _, err := Resolve(testAbility(), 0, units.Default()); err == nil {
+		t.Fatalf("expected star range error")
+	}
+	bad := testAbility()
+	bad.Targets = 0
+	if _, err := Resolve(bad, 1, units.Default()); err == nil {
+		t.Fatalf("expected validation error")
+	}
+}
+
+func TestResolve_NegativeAmpFloorsAtZero(t *testing.T) {
+	t.Parallel()
+	caster, err := units.NewStats(units.WithRange(1), units.WithDamageAmp(-2))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	c, err := Resolve(testAbility(), 1, caster)
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if c.Instances[0].Amount != 0 {
+		t.Fatalf("expected 0, got %v", c.Instances[0].Amount)
+	}
+}
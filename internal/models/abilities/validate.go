@@ -0,0 +1,86 @@
+package abilities
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/suggest"
+)
+
+// ValidateAbilitiesConfig checks every ability and reports all issues at once,
+// each prefixed by its config path (e.g. "Garen.base").
+func ValidateAbilitiesConfig(cfg AbilitiesLoader) error {
+	var issues []string
+	for champ, a := range cfg.Abilities {
+		for _, msg := range a.validate() {
+			issues = append(issues, champ+"."+msg)
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("abilities config validation issues: %v", issues)
+}
+
+// Validate checks a single ability definition.
+func (a Ability) Validate() error {
+	if issues := a.validate(); len(issues) > 0 {
+		return fmt.Errorf("invalid ability %q: %v", a.Name, issues)
+	}
+	return nil
+}
+
+func (a Ability) validate() []string {
+	var issues []string
+	if _, err := units.ParseDamageType(string(a.DamageType)); err != nil {
+		issues = append(issues, "damage_type: "+err.Error())
+	}
+	if a.Damage != "" {
+		if err := a.compileDamage(); err != nil {
+			issues = append(issues, "damage: "+err.Error())
+		}
+	}
+	if n := len(a.Base); (n == 0 && a.Damage == "") || n > MaxStar {
+		issues = append(issues, fmt.Sprintf("base: expected 1..%d values (one per star), got %d", MaxStar, n))
+	}
+	for i, v := range a.Base {
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+			issues = append(issues, fmt.Sprintf("base[%d]: must be finite and >= 0, got %v", i, v))
+		}
+	}
+	for name, v := range map[string]float64{"ap_ratio": a.APRatio, "ad_ratio": a.ADRatio, "cast_time": a.CastTime} {
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+			issues = append(issues, fmt.Sprintf("%s: must be finite and >= 0, got %v", name, v))
+		}
+	}
+	if _, ok := validTargeting[a.Targeting]; !ok {
+		issues = append(issues, fmt.Sprintf("targeting: unknown rule %q", a.Targeting))
+	}
+	if a.Targets < 1 {
+		issues = append(issues, fmt.Sprintf("targets: must be >= 1, got %d", a.Targets))
+	}
+	if s := a.Summon; s != nil {
+		if s.Name == "" {
+			issues = append(issues, "summon.name: empty")
+		}
+		if math.IsNaN(s.Lifetime) || math.IsInf(s.Lifetime, 0) || s.Lifetime < 0 {
+			issues = append(issues, fmt.Sprintf("summon.lifetime: must be finite and >= 0, got %v", s.Lifetime))
+		}
+		known := true
+		for k := range s.Stats {
+			if _, ok := units.ResolveStatPath(k); !ok {
+				hint, _ := suggest.Closest(k, units.StatPaths())
+				issues = append(issues, fmt.Sprintf("summon.stats.%s: unknown stat%s", k, suggest.DidYouMean(hint)))
+				known = false
+			}
+		}
+		if _, err := s.UnitStats(); known && err != nil {
+			issues = append(issues, "summon.stats: "+err.Error())
+		}
+	}
+	sort.Strings(issues)
+	return issues
+}
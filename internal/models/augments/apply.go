@@ -0,0 +1,63 @@
+package augments
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Apply returns u with the stat bonuses of every augment. Percent bonuses are
+// summed against u's stats as given (items included), flat bonuses are added,
+// and the result is validated and sanitized.
+func Apply(u units.Unit, augs ...Augment) (units.Unit, error) {
+	base := u.Stats
+	next := u.Stats
+	for _, a := range augs {
+		for _, k := range slices.Sorted(maps.Keys(a.Percent)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return units.Unit{}, fmt.Errorf("%s.percent: unknown stat %q", a.Name, k)
+			}
+			v, _ := base.Value(path)
+			cur, _ := next.Value(path)
+			next.SetValue(path, cur+v*a.Percent[k])
+		}
+		for _, k := range slices.Sorted(maps.Keys(a.Stats)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return units.Unit{}, fmt.Errorf("%s.stats: unknown stat %q", a.Name, k)
+			}
+			cur, _ := next.Value(path)
+			next.SetValue(path, cur+a.Stats[k])
+		}
+	}
+	st, err := next.With()
+	if err != nil {
+		return units.Unit{}, fmt.Errorf("%s: augments %v: %w", u.Name, Names(augs), err)
+	}
+	u.Stats = st
+	return u, nil
+}
+
+// TraitBonus counts the trait grants of augs, keyed by trait name as written.
+func TraitBonus(augs ...Augment) map[string]int {
+	out := map[string]int{}
+	for _, a := range augs {
+		for _, t := range a.Traits {
+			out[strings.TrimSpace(t)]++
+		}
+	}
+	return out
+}
+
+// Names lists augment names in order.
+func Names(augs []Augment) []string {
+	out := make([]string, len(augs))
+	for i, a := range augs {
+		out[i] = a.Name
+	}
+	return out
+}
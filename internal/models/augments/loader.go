@@ -0,0 +1,92 @@
+package augments
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// AugmentsLoader holds the augment catalog.
+type AugmentsLoader struct {
+	Meta     map[string]any `json:"sft,omitempty"` // version and sources, not interpreted
+	Augments []Augment      `json:"augments"`
+}
+
+// LoadAugments reads an augments config. Unknown fields are rejected so a
+// typo never silently drops a bonus.
+func LoadAugments(path string) (AugmentsLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return AugmentsLoader{}, fmt.Errorf("read augments config: %w", err)
+	}
+	var cfg AugmentsLoader
+	if err := json.Unmarshal(b, &cfg, json.RejectUnknownMembers(true)); err != nil {
+		return AugmentsLoader{}, fmt.Errorf("parse augments config: %w", err)
+	}
+	cfg.normalize()
+	return cfg, nil
+}
+
+// normalize canonicalizes case-insensitive enums; invalid values are kept
+// as-is so validation can report them.
+func (c AugmentsLoader) normalize() {
+	for i := range c.Augments {
+		a := &c.Augments[i]
+		a.Tier = Tier(strings.ToLower(strings.TrimSpace(string(a.Tier))))
+		for j := range a.Hooks {
+			a.Hooks[j] = a.Hooks[j].Normalized()
+		}
+	}
+}
+
+// Normalized canonicalizes the hook's case-insensitive enums; invalid values
+// are kept as-is so validation can report them.
+func (h Hook) Normalized() Hook {
+	h.Trigger = Trigger(strings.ToLower(strings.TrimSpace(string(h.Trigger))))
+	h.Action = Action(strings.ToLower(strings.TrimSpace(string(h.Action))))
+	if dt, err := units.ParseDamageType(string(h.DamageType)); err == nil {
+		h.DamageType = dt
+	}
+	return h
+}
+
+// Lookup finds an augment by name (case-insensitive, surrounding spaces ignored).
+func (c AugmentsLoader) Lookup(name string) (Augment, bool) {
+	n := strings.TrimSpace(name)
+	for _, a := range c.Augments {
+		if strings.EqualFold(a.Name, n) {
+			return a, true
+		}
+	}
+	return Augment{}, false
+}
+
+// Names returns every augment name in config order.
+func (c AugmentsLoader) Names() []string {
+	out := make([]string, 0, len(c.Augments))
+	for _, a := range c.Augments {
+		out = append(out, a.Name)
+	}
+	return out
+}
+
+// ResolveItems fills each augment's Granted items from the catalog.
+func (c AugmentsLoader) ResolveItems(catalog items.ItemsLoader) error {
+	for i := range c.Augments {
+		a := &c.Augments[i]
+		a.Granted = a.Granted[:0]
+		for _, n := range a.Items {
+			it, ok := catalog.Lookup(n)
+			if !ok {
+				return fmt.Errorf("%s.items: unknown item %q", a.Name, n)
+			}
+			a.Granted = append(a.Granted, it)
+		}
+	}
+	return nil
+}
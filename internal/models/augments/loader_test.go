@@ -0,0 +1,124 @@
+package augments
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func catalogs(t *testing.T) (items.ItemsLoader, units.TraitsLoader) {
+	t.Helper()
+	catalog, err := items.LoadItems("../../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load items: %v", err)
+	}
+	traits, err := units.LoadTraits("../../config/set15/traits.json")
+	if err != nil {
+		t.Fatalf("load traits: %v", err)
+	}
+	return catalog, traits
+}
+
+func TestLoadAugments_Set15Config(t *testing.T) {
+	t.Parallel()
+	cfg, err := LoadAugments("../../config/set15/augments.json")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	catalog, traits := catalogs(t)
+	if err := ValidateAugmentsConfig(cfg, catalog, traits); err != nil {
+		t.Fatalf("set15 augments invalid: %v", err)
+	}
+	if err := cfg.ResolveItems(catalog); err != nil {
+		t.Fatalf("resolve items: %v", err)
+	}
+	bag, ok := cfg.Lookup("component grab bag")
+	if !ok || len(bag.Granted) != 2 || bag.Granted[0].Name != "B.F. Sword" {
+		t.Fatalf("Component Grab Bag = %+v, %v", bag, ok)
+	}
+	for _, a := range cfg.Augments {
+		for _, h := range a.Hooks {
+			if _, ok := validTriggers[h.Trigger]; !ok {
+				t.Fatalf("%s: trigger %q not normalized", a.Name, h.Trigger)
+			}
+		}
+	}
+}
+
+func TestLoadAugments_RejectsUnknownFields(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "augments.json")
+	body := `{"augments": [{"name": "Typo", "tier": "silver", "stat": {"AD": 10}}]}`
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := LoadAugments(path); err == nil || !strings.Contains(err.Error(), "stat") {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+}
+
+func TestValidateAugmentsConfig_ReportsIssues(t *testing.T) {
+	t.Parallel()
+	catalog, traits := catalogs(t)
+	cfg := AugmentsLoader{Augments: []Augment{
+		{Name: "A", Tier: "bronze", Stats: map[string]float64{"atack_speed": 0.1}},
+		{Name: "B", Tier: Gold, Items: []string{"Deathblad"}, Traits: []string{"Snipper"}},
+		{Name: "C", Tier: Gold, Hooks: []Hook{
+			{Trigger: Every, Action: ActionHeal, Amount: 10},
+			{Trigger: "on_death", Action: ActionDamage, Amount: 10},
+			{Trigger: OnCast, Action: ActionBuff},
+			{Trigger: StartOfCombat, Action: "explode"},
+		}},
+		{Name: "D", Tier: Silver},
+		{Name: "a", Tier: Silver, Stats: map[string]float64{"AD": 1}},
+	}}
+	err := ValidateAugmentsConfig(cfg, catalog, traits)
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	for _, want := range []string{
+		"A.tier",
+		"A.stats.atack_speed: unknown stat",
+		`B.items: unknown item "Deathblad" (did you mean "Deathblade"?)`,
+		`B.traits: unknown trait "Snipper" (did you mean "Sniper"?)`,
+		"C.hooks[0].every: must be > 0",
+		"C.hooks[1].damage_type",
+		"C.hooks[1].trigger: unknown trigger",
+		"C.hooks[2].stats: a buff needs stats or percent",
+		"C.hooks[3].action: unknown action",
+		"D.augment grants nothing",
+		"a: duplicate augment",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
+
+func TestApply_StatsAndTraitBonus(t *testing.T) {
+	t.Parallel()
+	st, err := units.NewStats(units.WithAD(50), units.WithHP(1000))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	u := units.Unit{Name: "carry", Stats: st}
+	augs := []Augment{
+		{Name: "Combat Training", Tier: Silver, Percent: map[string]float64{"attack_damage": 0.1}},
+		{Name: "Stand United", Tier: Gold, Stats: map[string]float64{"AD": 8}, Traits: []string{"Sniper"}},
+		{Name: "Sniper Crest", Tier: Gold, Traits: []string{"Sniper"}},
+	}
+	got, err := Apply(u, augs...)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if ad := got.Stats.Offense.AD; ad < 62.999 || ad > 63.001 {
+		t.Fatalf("AD = %v, want 50 × 1.1 + 8 = 63", ad)
+	}
+	if n := TraitBonus(augs...)["Sniper"]; n != 2 {
+		t.Fatalf("Sniper bonus = %d, want 2", n)
+	}
+}
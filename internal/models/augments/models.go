@@ -0,0 +1,73 @@
+package augments
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Tier is an augment's rarity.
+type Tier string
+
+const (
+	Silver    Tier = "silver"
+	Gold      Tier = "gold"
+	Prismatic Tier = "prismatic"
+)
+
+var validTiers = map[Tier]struct{}{Silver: {}, Gold: {}, Prismatic: {}}
+
+// Trigger decides when a hook fires for each unit.
+type Trigger string
+
+const (
+	StartOfCombat Trigger = "start_of_combat" // once, before the first action
+	OnCast        Trigger = "on_cast"         // after each of the unit's spells lands
+	Every         Trigger = "every"           // every Hook.Every seconds
+)
+
+var validTriggers = map[Trigger]struct{}{StartOfCombat: {}, OnCast: {}, Every: {}}
+
+// Action is what a hook does when it fires.
+type Action string
+
+const (
+	ActionDamage Action = "damage" // Amount of DamageType damage to the unit's target (nearest enemy if none)
+	ActionHeal   Action = "heal"   // Amount HP to the unit
+	ActionShield Action = "shield" // Amount shield on the unit for Duration
+	ActionMana   Action = "mana"   // Amount mana to the unit
+	ActionBuff   Action = "buff"   // Stats/Percent per stack for Duration, up to MaxStacks
+)
+
+var validActions = map[Action]struct{}{ActionDamage: {}, ActionHeal: {}, ActionShield: {}, ActionMana: {}, ActionBuff: {}}
+
+// Hook is an augment's combat behaviour; the sim package runs it on every unit
+// of the player's board.
+type Hook struct {
+	Trigger    Trigger            `json:"trigger"`
+	Every      float64            `json:"every,omitempty"` // seconds between firings of an every hook
+	Action     Action             `json:"action"`
+	Amount     float64            `json:"amount,omitempty"`
+	DamageType units.DamageType   `json:"damage_type,omitempty"`
+	Stats      map[string]float64 `json:"stats,omitempty"`      // buff: flat bonus per stack
+	Percent    map[string]float64 `json:"percent,omitempty"`    // buff: fraction of the current value per stack
+	Duration   float64            `json:"duration,omitempty"`   // buff/shield seconds; 0 → rest of combat
+	MaxStacks  int                `json:"max_stacks,omitempty"` // buff stacks (default 1)
+}
+
+// Augment is a player-level bonus: it applies to every unit of the board.
+//
+// Stats and Percent work like item bonuses (Percent scales the unit's value
+// with items). Items are granted to the board, each to the first unit that
+// can hold it. Traits add one to the board count of each listed trait.
+type Augment struct {
+	Name    string             `json:"name"`
+	Tier    Tier               `json:"tier"`
+	Stats   map[string]float64 `json:"stats,omitempty"`
+	Percent map[string]float64 `json:"percent,omitempty"`
+	Items   []string           `json:"items,omitempty"`
+	Traits  []string           `json:"traits,omitempty"`
+	Hooks   []Hook             `json:"hooks,omitempty"`
+
+	// Granted holds the resolved Items (see AugmentsLoader.ResolveItems).
+	Granted []items.Item `json:"-"`
+}
@@ -0,0 +1,154 @@
+package augments
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/suggest"
+)
+
+// ValidateAugmentsConfig checks the whole catalog against the item and trait
+// catalogs and reports all issues at once, each prefixed by the augment name
+// (e.g. "Cybernetic Implants.hooks[0].action").
+func ValidateAugmentsConfig(cfg AugmentsLoader, catalog items.ItemsLoader, traits units.TraitsLoader) error {
+	var issues []string
+	seen := map[string]struct{}{}
+	for i, a := range cfg.Augments {
+		key := strings.ToLower(strings.TrimSpace(a.Name))
+		if key == "" {
+			issues = append(issues, fmt.Sprintf("augments[%d]: empty name", i))
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			issues = append(issues, a.Name+": duplicate augment")
+		}
+		seen[key] = struct{}{}
+		for _, msg := range a.validate() {
+			issues = append(issues, a.Name+"."+msg)
+		}
+		for _, n := range a.Items {
+			if _, ok := catalog.Lookup(n); !ok {
+				hint, _ := suggest.Closest(n, catalog.Names())
+				issues = append(issues, fmt.Sprintf("%s.items: unknown item %q%s", a.Name, n, suggest.DidYouMean(hint)))
+			}
+		}
+		for _, n := range a.Traits {
+			if _, ok := traits.Lookup(n); !ok {
+				hint, _ := suggest.Closest(n, traits.Names())
+				issues = append(issues, fmt.Sprintf("%s.traits: unknown trait %q%s", a.Name, n, suggest.DidYouMean(hint)))
+			}
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("augments config validation issues: %v", issues)
+}
+
+// Validate checks a single augment on its own (items and traits are checked
+// against their catalogs by ValidateAugmentsConfig).
+func (a Augment) Validate() error {
+	if issues := a.validate(); len(issues) > 0 {
+		return fmt.Errorf("invalid augment %q: %v", a.Name, issues)
+	}
+	return nil
+}
+
+func (a Augment) validate() []string {
+	var issues []string
+	if _, ok := validTiers[a.Tier]; !ok {
+		issues = append(issues, fmt.Sprintf("tier: expected %q, %q or %q, got %q", Silver, Gold, Prismatic, a.Tier))
+	}
+	issues = append(issues, statIssues("stats", a.Stats)...)
+	issues = append(issues, statIssues("percent", a.Percent)...)
+	if len(a.Items) > items.MaxItems {
+		issues = append(issues, fmt.Sprintf("items: at most %d, got %d", items.MaxItems, len(a.Items)))
+	}
+	if len(a.Stats)+len(a.Percent)+len(a.Items)+len(a.Traits)+len(a.Hooks) == 0 {
+		issues = append(issues, "augment grants nothing")
+	}
+	for i, h := range a.Hooks {
+		for _, msg := range h.validate() {
+			issues = append(issues, fmt.Sprintf("hooks[%d].%s", i, msg))
+		}
+	}
+	sort.Strings(issues)
+	return issues
+}
+
+// Validate checks a single hook; other packages reuse hooks (power-ups).
+func (h Hook) Validate() error {
+	if issues := h.validate(); len(issues) > 0 {
+		return fmt.Errorf("invalid hook: %v", issues)
+	}
+	return nil
+}
+
+func (h Hook) validate() []string {
+	var issues []string
+	finite := func(field string, v float64) bool {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			issues = append(issues, fmt.Sprintf("%s: must be finite, got %v", field, v))
+			return false
+		}
+		return true
+	}
+	if _, ok := validTriggers[h.Trigger]; !ok {
+		issues = append(issues, fmt.Sprintf("trigger: unknown trigger %q (want start_of_combat, on_cast or every)", h.Trigger))
+	}
+	if finite("every", h.Every) {
+		if h.Trigger == Every && h.Every <= 0 {
+			issues = append(issues, fmt.Sprintf("every: must be > 0 for an every hook, got %v", h.Every))
+		}
+		if h.Trigger != Every && h.Every != 0 {
+			issues = append(issues, "every: only for an every hook")
+		}
+	}
+	if finite("duration", h.Duration) && h.Duration < 0 {
+		issues = append(issues, fmt.Sprintf("duration: must be >= 0, got %v", h.Duration))
+	}
+	switch h.Action {
+	case ActionDamage, ActionHeal, ActionShield, ActionMana:
+		if finite("amount", h.Amount) && h.Amount <= 0 {
+			issues = append(issues, fmt.Sprintf("amount: must be > 0, got %v", h.Amount))
+		}
+		if _, err := units.ParseDamageType(string(h.DamageType)); h.Action == ActionDamage && err != nil {
+			issues = append(issues, "damage_type: "+err.Error())
+		}
+		if len(h.Stats)+len(h.Percent) > 0 {
+			issues = append(issues, fmt.Sprintf("stats: only for a %s hook", ActionBuff))
+		}
+	case ActionBuff:
+		if len(h.Stats)+len(h.Percent) == 0 {
+			issues = append(issues, "stats: a buff needs stats or percent")
+		}
+		issues = append(issues, statIssues("stats", h.Stats)...)
+		issues = append(issues, statIssues("percent", h.Percent)...)
+		if h.MaxStacks < 0 {
+			issues = append(issues, fmt.Sprintf("max_stacks: must be >= 0, got %d", h.MaxStacks))
+		}
+	default:
+		issues = append(issues, fmt.Sprintf("action: unknown action %q (want damage, heal, shield, mana or buff)", h.Action))
+	}
+	return issues
+}
+
+// statIssues reports unknown stat keys (with hints) and non-finite values.
+func statIssues(field string, m map[string]float64) []string {
+	var issues []string
+	for k, v := range m {
+		if _, ok := units.ResolveStatPath(k); !ok {
+			hint, _ := suggest.Closest(k, units.StatPaths())
+			issues = append(issues, fmt.Sprintf("%s.%s: unknown stat%s", field, k, suggest.DidYouMean(hint)))
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			issues = append(issues, fmt.Sprintf("%s.%s: must be finite, got %v", field, k, v))
+		}
+	}
+	return issues
+}
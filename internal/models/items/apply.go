@@ -0,0 +1,95 @@
+package items
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Equip returns u holding the given items. Percent bonuses scale u's pre-item
+// stats, flat bonuses are added on top, and the result is validated and
+// sanitized like any other stats change. Emblems add their trait to
+// u.Traits; an emblem for a trait u already has is rejected.
+func Equip(u units.Unit, held ...Item) (units.Unit, error) {
+	if len(held) > MaxItems {
+		return units.Unit{}, fmt.Errorf("%s: %d items, at most %d fit", u.Name, len(held), MaxItems)
+	}
+	count := map[string]int{}
+	for _, it := range held {
+		if count[it.Name]++; it.Unique && count[it.Name] > 1 {
+			return units.Unit{}, fmt.Errorf("%s: %s is unique", u.Name, it.Name)
+		}
+	}
+
+	base := u.Stats
+	next := u.Stats
+	for _, it := range held {
+		for _, k := range slices.Sorted(maps.Keys(it.Percent)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return units.Unit{}, fmt.Errorf("%s.percent: unknown stat %q", it.Name, k)
+			}
+			v, _ := base.Value(path)
+			cur, _ := next.Value(path)
+			next.SetValue(path, cur+v*it.Percent[k])
+		}
+		for _, k := range slices.Sorted(maps.Keys(it.Stats)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return units.Unit{}, fmt.Errorf("%s.stats: unknown stat %q", it.Name, k)
+			}
+			cur, _ := next.Value(path)
+			next.SetValue(path, cur+it.Stats[k])
+		}
+	}
+	st, err := next.With()
+	if err != nil {
+		return units.Unit{}, fmt.Errorf("%s: equip %v: %w", u.Name, Names(held), err)
+	}
+	if u.Traits, err = withEmblems(u, held); err != nil {
+		return units.Unit{}, err
+	}
+	u.Stats = st
+	return u, nil
+}
+
+// withEmblems returns u's traits plus the traits of the emblems held.
+func withEmblems(u units.Unit, held []Item) ([]string, error) {
+	traits := u.Traits
+	for _, it := range held {
+		if it.Kind != Emblem {
+			continue
+		}
+		if slices.ContainsFunc(traits, func(t string) bool { return strings.EqualFold(strings.TrimSpace(t), strings.TrimSpace(it.Trait)) }) {
+			return nil, fmt.Errorf("%s already has trait %s: %s would duplicate it", u.Name, it.Trait, it.Name)
+		}
+		traits = append(slices.Clone(traits), it.Trait)
+	}
+	return traits, nil
+}
+
+// Names lists item names in order.
+func Names(held []Item) []string {
+	out := make([]string, len(held))
+	for i, it := range held {
+		out[i] = it.Name
+	}
+	return out
+}
+
+// Components returns the components a set of items is built from, in order
+// (a component counts as itself).
+func Components(held []Item) []string {
+	var out []string
+	for _, it := range held {
+		if it.Kind == Completed {
+			out = append(out, it.Recipe...)
+		} else {
+			out = append(out, it.Name)
+		}
+	}
+	return out
+}
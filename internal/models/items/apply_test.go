@@ -0,0 +1,88 @@
+package items
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func testUnit(t *testing.T) units.Unit {
+	t.Helper()
+	s, err := units.NewStats(units.WithAD(50), units.WithAS(0.8), units.WithHP(700))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	return units.Unit{Name: "carry", Stats: s}
+}
+
+func TestEquip_PercentScalesPreItemStats(t *testing.T) {
+	t.Parallel()
+	cfg := loadSet15(t)
+	db, _ := cfg.Lookup("Deathblade")
+	belt, _ := cfg.Lookup("Giant's Belt")
+	u, err := Equip(testUnit(t), db, db, belt)
+	if err != nil {
+		t.Fatalf("equip: %v", err)
+	}
+	// Two Deathblades: +55% of the pre-item 50 AD each, not compounded.
+	if got := u.Stats.Offense.AD; got < 104.999 || got > 105.001 {
+		t.Fatalf("AD = %v, want 105", got)
+	}
+	if got := u.Stats.Offense.DamageAmp; got < 0.1999 || got > 0.2001 {
+		t.Fatalf("damage amp = %v, want 0.2", got)
+	}
+	if got := u.Stats.Defense.HP; got != 850 {
+		t.Fatalf("HP = %v, want 850", got)
+	}
+}
+
+func TestEquip_Limits(t *testing.T) {
+	t.Parallel()
+	cfg := loadSet15(t)
+	thief, _ := cfg.Lookup("Thief's Gloves")
+	if _, err := Equip(testUnit(t), thief, thief); err == nil {
+		t.Fatalf("expected unique item error")
+	}
+	if _, err := Equip(testUnit(t), thief, thief, thief, thief); err == nil {
+		t.Fatalf("expected too many items error")
+	}
+}
+
+func TestEquip_EmblemAddsTrait(t *testing.T) {
+	t.Parallel()
+	cfg := loadSet15(t)
+	sniper, _ := cfg.Lookup("Sniper Emblem")
+	wraith, _ := cfg.Lookup("Wraith Emblem")
+	base := testUnit(t)
+	base.Traits = []string{"Star Guardian", "Sniper"}
+	if _, err := Equip(base, sniper); err == nil {
+		t.Fatalf("expected an error for an emblem of a trait the unit has")
+	}
+	u, err := Equip(base, wraith)
+	if err != nil {
+		t.Fatalf("equip: %v", err)
+	}
+	if len(u.Traits) != 3 || u.Traits[2] != "Wraith" || len(base.Traits) != 2 {
+		t.Fatalf("traits = %v (base %v)", u.Traits, base.Traits)
+	}
+	if _, err := Equip(base, wraith, wraith); err == nil {
+		t.Fatalf("expected an error for two emblems of one trait")
+	}
+
+	traits, err := units.LoadTraits("../../config/set15/traits.json")
+	if err != nil {
+		t.Fatalf("load traits: %v", err)
+	}
+	if err := ValidateEmblems(cfg, traits); err != nil {
+		t.Fatalf("set15 emblems invalid: %v", err)
+	}
+	bad := ItemsLoader{Items: []Item{
+		{Name: "Snipr Emblem", Kind: Emblem, Trait: "Snipr"},
+		{Name: "Champ Emblem", Kind: Emblem, Trait: "The Champ"},
+	}}
+	err = ValidateEmblems(bad, traits)
+	if err == nil || !strings.Contains(err.Error(), `unknown trait "Snipr" (did you mean "Sniper"?)`) || !strings.Contains(err.Error(), "The Champ is a unique trait") {
+		t.Fatalf("emblem validation: %v", err)
+	}
+}
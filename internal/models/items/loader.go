@@ -0,0 +1,90 @@
+package items
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// ItemsLoader holds the item catalog: components first, then completed items.
+type ItemsLoader struct {
+	Meta  map[string]any `json:"sft,omitempty"` // version and sources, not interpreted
+	Items []Item         `json:"items"`
+}
+
+// LoadItems reads an items config. Unknown fields are rejected so a typo
+// never silently drops a bonus.
+func LoadItems(path string) (ItemsLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ItemsLoader{}, fmt.Errorf("read items config: %w", err)
+	}
+	var cfg ItemsLoader
+	if err := json.Unmarshal(b, &cfg, json.RejectUnknownMembers(true)); err != nil {
+		return ItemsLoader{}, fmt.Errorf("parse items config: %w", err)
+	}
+	cfg.normalize()
+	return cfg, nil
+}
+
+// normalize canonicalizes case-insensitive enums; invalid values are kept
+// as-is so validation can report them.
+func (c ItemsLoader) normalize() {
+	for i := range c.Items {
+		it := &c.Items[i]
+		it.Kind = Kind(strings.ToLower(strings.TrimSpace(string(it.Kind))))
+		if it.Passive != nil {
+			it.Passive.Kind = PassiveKind(strings.ToLower(strings.TrimSpace(string(it.Passive.Kind))))
+			if dt, err := units.ParseDamageType(string(it.Passive.DamageType)); err == nil {
+				it.Passive.DamageType = dt
+			}
+		}
+	}
+}
+
+// Lookup finds an item by name (case-insensitive, surrounding spaces ignored).
+func (c ItemsLoader) Lookup(name string) (Item, bool) {
+	n := strings.TrimSpace(name)
+	for _, it := range c.Items {
+		if strings.EqualFold(it.Name, n) {
+			return it, true
+		}
+	}
+	return Item{}, false
+}
+
+// Names returns every item name in config order.
+func (c ItemsLoader) Names() []string {
+	out := make([]string, 0, len(c.Items))
+	for _, it := range c.Items {
+		out = append(out, it.Name)
+	}
+	return out
+}
+
+// OfKind returns the items of one kind in config order.
+func (c ItemsLoader) OfKind(k Kind) []Item {
+	var out []Item
+	for _, it := range c.Items {
+		if it.Kind == k {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// Combine returns the completed item built from two components, in either order.
+func (c ItemsLoader) Combine(a, b string) (Item, bool) {
+	for _, it := range c.Items {
+		if it.Kind == Completed && len(it.Recipe) == 2 &&
+			(strings.EqualFold(it.Recipe[0], a) && strings.EqualFold(it.Recipe[1], b) ||
+				strings.EqualFold(it.Recipe[0], b) && strings.EqualFold(it.Recipe[1], a)) {
+			return it, true
+		}
+	}
+	return Item{}, false
+}
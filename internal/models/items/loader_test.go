@@ -0,0 +1,76 @@
+package items
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func loadSet15(t *testing.T) ItemsLoader {
+	t.Helper()
+	cfg, err := LoadItems("../../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	return cfg
+}
+
+func TestLoadItems_Set15Config(t *testing.T) {
+	t.Parallel()
+	cfg := loadSet15(t)
+	if err := ValidateItemsConfig(cfg); err != nil {
+		t.Fatalf("set15 items invalid: %v", err)
+	}
+	comps, done := cfg.OfKind(Component), cfg.OfKind(Completed)
+	if n := len(comps); len(done) != n*(n+1)/2 {
+		t.Fatalf("expected one completed item per component pair: %d components, %d items", n, len(done))
+	}
+	it, ok := cfg.Combine("recurve bow", "B.F. Sword")
+	if !ok || it.Name != "Giant Slayer" {
+		t.Fatalf("Combine(bow, sword) = %q, %v", it.Name, ok)
+	}
+}
+
+func TestLoadItems_RejectsUnknownFields(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "items.json")
+	body := `{"items": [{"name": "Sword", "kind": "component", "stat": {"AD": 10}}]}`
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := LoadItems(path); err == nil || !strings.Contains(err.Error(), "stat") {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+}
+
+func TestValidateItemsConfig_ReportsIssues(t *testing.T) {
+	t.Parallel()
+	cfg := ItemsLoader{Items: []Item{
+		{Name: "Sword", Kind: Component, Stats: map[string]float64{"AD": 10}},
+		{Name: "A", Kind: Completed, Recipe: []string{"Sword", "Sowrd"}, Stats: map[string]float64{"atack_speed": 1}},
+		{Name: "B", Kind: Completed, Recipe: []string{"Sword", "Sowrd"}, Passive: &Passive{Kind: "explode", Amount: 1}},
+		{Name: "C", Kind: "relic"},
+		{Name: "D", Kind: Emblem},
+		{Name: "E", Kind: Component, Trait: "Sniper"},
+		{Name: "sword", Kind: Component},
+	}}
+	err := ValidateItemsConfig(cfg)
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	for _, want := range []string{
+		`A.recipe: unknown component "Sowrd" (did you mean "Sword"?)`,
+		"A.stats.atack_speed: unknown stat",
+		"B.recipe: same components as A",
+		"B.passive.kind",
+		"C.kind",
+		"D.trait: emblems must name a trait",
+		"E.trait: only emblems grant a trait",
+		"sword: duplicate item",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package items
+
+import "github.com/0xm0-v1/simfight-tactics/internal/models/units"
+
+// Kind tells components (dropped loose) from completed items (two components
+// combined) and emblems (grant a trait).
+type Kind string
+
+const (
+	Component Kind = "component"
+	Completed Kind = "completed"
+	Emblem    Kind = "emblem"
+)
+
+// MaxItems is the number of item slots of a champion.
+const MaxItems = 3
+
+// PassiveKind selects a built-in on-hit behaviour for an item.
+type PassiveKind string
+
+const (
+	PassiveBonusDamage PassiveKind = "bonus_damage" // Amount of DamageType damage on every hit
+	PassiveManaOnHit   PassiveKind = "mana_on_hit"  // Amount extra mana on every hit
+	PassiveStackingAS  PassiveKind = "stacking_as"  // Amount attack speed per hit, up to MaxStacks
+)
+
+var validPassives = map[PassiveKind]struct{}{
+	PassiveBonusDamage: {}, PassiveManaOnHit: {}, PassiveStackingAS: {},
+}
+
+// Passive is an item's combat behaviour; the sim package turns it into an on-hit hook.
+type Passive struct {
+	Kind       PassiveKind      `json:"kind"`
+	DamageType units.DamageType `json:"damage_type,omitempty"`
+	Amount     float64          `json:"amount"`
+	MaxStacks  int              `json:"max_stacks,omitempty"`
+}
+
+// Item is a component or a completed item.
+//
+// Stats are flat bonuses and Percent bonuses scale the holder's pre-item value
+// (B.F. Sword: +10% attack damage). Keys are stat paths or aliases ("AP",
+// "attack_speed", "defense.hp").
+type Item struct {
+	Name    string             `json:"name"`
+	Kind    Kind               `json:"kind"`
+	Recipe  []string           `json:"recipe,omitempty"` // the two components of a completed item
+	Stats   map[string]float64 `json:"stats,omitempty"`
+	Percent map[string]float64 `json:"percent,omitempty"`
+	Passive *Passive           `json:"passive,omitempty"`
+	Unique  bool               `json:"unique,omitempty"` // at most one copy per champion
+	Trait   string             `json:"trait,omitempty"`  // trait an emblem adds to its holder
+}
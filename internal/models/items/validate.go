@@ -0,0 +1,144 @@
+package items
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/suggest"
+)
+
+// ValidateItemsConfig checks the whole catalog and reports all issues at once,
+// each prefixed by the item name (e.g. "Deathblade.recipe").
+func ValidateItemsConfig(cfg ItemsLoader) error {
+	var issues []string
+	seen := map[string]struct{}{}
+	recipes := map[string]string{}
+	components := map[string]struct{}{}
+	var componentNames []string
+	for _, it := range cfg.OfKind(Component) {
+		components[strings.ToLower(strings.TrimSpace(it.Name))] = struct{}{}
+		componentNames = append(componentNames, it.Name)
+	}
+
+	for i, it := range cfg.Items {
+		key := strings.ToLower(strings.TrimSpace(it.Name))
+		if key == "" {
+			issues = append(issues, fmt.Sprintf("items[%d]: empty name", i))
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			issues = append(issues, it.Name+": duplicate item")
+		}
+		seen[key] = struct{}{}
+		for _, msg := range it.validate() {
+			issues = append(issues, it.Name+"."+msg)
+		}
+		if it.Kind != Completed || len(it.Recipe) != 2 {
+			continue
+		}
+		for _, c := range it.Recipe {
+			if _, ok := components[strings.ToLower(strings.TrimSpace(c))]; !ok {
+				hint, _ := suggest.Closest(c, componentNames)
+				issues = append(issues, fmt.Sprintf("%s.recipe: unknown component %q%s", it.Name, c, suggest.DidYouMean(hint)))
+			}
+		}
+		pair := []string{strings.ToLower(it.Recipe[0]), strings.ToLower(it.Recipe[1])}
+		slices.Sort(pair)
+		if other, dup := recipes[strings.Join(pair, "+")]; dup {
+			issues = append(issues, fmt.Sprintf("%s.recipe: same components as %s", it.Name, other))
+		}
+		recipes[strings.Join(pair, "+")] = it.Name
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("items config validation issues: %v", issues)
+}
+
+// ValidateEmblems checks emblem traits against the trait catalog: each must
+// exist and have a breakpoint above 1 (a unique trait cannot be granted).
+func ValidateEmblems(cfg ItemsLoader, traits units.TraitsLoader) error {
+	var issues []string
+	for _, it := range cfg.OfKind(Emblem) {
+		t, ok := traits.Lookup(it.Trait)
+		switch {
+		case !ok:
+			hint, _ := suggest.Closest(it.Trait, traits.Names())
+			issues = append(issues, fmt.Sprintf("%s.trait: unknown trait %q%s", it.Name, it.Trait, suggest.DidYouMean(hint)))
+		case t.Unique():
+			issues = append(issues, fmt.Sprintf("%s.trait: %s is a unique trait", it.Name, t.Name))
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("emblem validation issues: %v", issues)
+}
+
+// Validate checks a single item on its own (recipes are checked against the
+// catalog by ValidateItemsConfig).
+func (it Item) Validate() error {
+	if issues := it.validate(); len(issues) > 0 {
+		return fmt.Errorf("invalid item %q: %v", it.Name, issues)
+	}
+	return nil
+}
+
+func (it Item) validate() []string {
+	var issues []string
+	switch it.Kind {
+	case Component:
+		if len(it.Recipe) > 0 {
+			issues = append(issues, "recipe: components have no recipe")
+		}
+	case Completed:
+		if len(it.Recipe) != 2 {
+			issues = append(issues, fmt.Sprintf("recipe: expected 2 components, got %d", len(it.Recipe)))
+		}
+	case Emblem:
+		if len(it.Recipe) > 0 {
+			issues = append(issues, "recipe: emblems have no recipe")
+		}
+		if strings.TrimSpace(it.Trait) == "" {
+			issues = append(issues, "trait: emblems must name a trait")
+		}
+	default:
+		issues = append(issues, fmt.Sprintf("kind: expected %q, %q or %q, got %q", Component, Completed, Emblem, it.Kind))
+	}
+	if it.Trait != "" && it.Kind != Emblem {
+		issues = append(issues, "trait: only emblems grant a trait")
+	}
+	for field, m := range map[string]map[string]float64{"stats": it.Stats, "percent": it.Percent} {
+		for k, v := range m {
+			if _, ok := units.ResolveStatPath(k); !ok {
+				hint, _ := suggest.Closest(k, units.StatPaths())
+				issues = append(issues, fmt.Sprintf("%s.%s: unknown stat%s", field, k, suggest.DidYouMean(hint)))
+			}
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				issues = append(issues, fmt.Sprintf("%s.%s: must be finite, got %v", field, k, v))
+			}
+		}
+	}
+	if p := it.Passive; p != nil {
+		if _, ok := validPassives[p.Kind]; !ok {
+			issues = append(issues, fmt.Sprintf("passive.kind: unknown passive %q", p.Kind))
+		}
+		if math.IsNaN(p.Amount) || math.IsInf(p.Amount, 0) || p.Amount <= 0 {
+			issues = append(issues, fmt.Sprintf("passive.amount: must be finite and > 0, got %v", p.Amount))
+		}
+		if _, err := units.ParseDamageType(string(p.DamageType)); p.Kind == PassiveBonusDamage && err != nil {
+			issues = append(issues, "passive.damage_type: "+err.Error())
+		}
+		if p.Kind == PassiveStackingAS && p.MaxStacks < 1 {
+			issues = append(issues, fmt.Sprintf("passive.max_stacks: must be >= 1, got %d", p.MaxStacks))
+		}
+	}
+	sort.Strings(issues)
+	return issues
+}
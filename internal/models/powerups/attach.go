@@ -0,0 +1,80 @@
+package powerups
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Check reports why u may not take a power-up with these rules (nil → eligible).
+func (e Eligibility) Check(u units.Unit) error {
+	if len(e.Costs) > 0 && !slices.Contains(e.Costs, u.Cost) {
+		return fmt.Errorf("%s costs %d, power-up needs cost %v", u.Name, u.Cost, e.Costs)
+	}
+	if len(e.Roles) == 0 {
+		return nil
+	}
+	for _, label := range u.Roles {
+		for _, tok := range units.RoleTokens(label) {
+			if slices.ContainsFunc(e.Roles, func(r string) bool { return strings.EqualFold(r, tok) }) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s has roles %v, power-up needs one of %v", u.Name, u.Roles, e.Roles)
+}
+
+// Options returns the power-up's stat changes as stats options: percent
+// bonuses scale the value the option receives, then flat bonuses are added.
+func (p PowerUp) Options() []units.Option {
+	if len(p.Stats)+len(p.Percent) == 0 {
+		return nil
+	}
+	return []units.Option{func(s *units.Stats) error {
+		base := *s
+		for _, k := range slices.Sorted(maps.Keys(p.Percent)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return fmt.Errorf("%s.percent: unknown stat %q", p.Name, k)
+			}
+			v, _ := base.Value(path)
+			cur, _ := s.Value(path)
+			s.SetValue(path, cur+v*p.Percent[k])
+		}
+		for _, k := range slices.Sorted(maps.Keys(p.Stats)) {
+			path, ok := units.ResolveStatPath(k)
+			if !ok {
+				return fmt.Errorf("%s.stats: unknown stat %q", p.Name, k)
+			}
+			cur, _ := s.Value(path)
+			s.SetValue(path, cur+p.Stats[k])
+		}
+		return nil
+	}}
+}
+
+// Attach returns u (as built, before items) with the power-up: eligibility
+// is checked, the stat options are applied and u.PowerUp records the name.
+// A champion holds at most one power-up. Hooks and the ability modifier are
+// combat behaviour: sim.Placement.PowerUp runs them.
+func Attach(u units.Unit, p PowerUp) (units.Unit, error) {
+	if u.PowerUp != "" {
+		return units.Unit{}, fmt.Errorf("%s already has power-up %q", u.Name, u.PowerUp)
+	}
+	if err := p.Validate(); err != nil {
+		return units.Unit{}, err
+	}
+	if err := p.Eligible.Check(u); err != nil {
+		return units.Unit{}, fmt.Errorf("power-up %q: %w", p.Name, err)
+	}
+	st, err := u.Stats.With(p.Options()...)
+	if err != nil {
+		return units.Unit{}, fmt.Errorf("%s: power-up %q: %w", u.Name, p.Name, err)
+	}
+	u.Stats = st
+	u.PowerUp = p.Name
+	return u, nil
+}
@@ -0,0 +1,82 @@
+package powerups
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	json "encoding/json/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// PowerUpsLoader holds the power-up catalog.
+type PowerUpsLoader struct {
+	Meta     map[string]any `json:"sft,omitempty"` // version and sources, not interpreted
+	PowerUps []PowerUp      `json:"power_ups"`
+}
+
+// LoadPowerUps reads a power-ups config. Unknown fields are rejected like in
+// the augments config.
+func LoadPowerUps(path string) (PowerUpsLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return PowerUpsLoader{}, fmt.Errorf("read power-ups config: %w", err)
+	}
+	var cfg PowerUpsLoader
+	if err := json.Unmarshal(b, &cfg, json.RejectUnknownMembers(true)); err != nil {
+		return PowerUpsLoader{}, fmt.Errorf("parse power-ups config: %w", err)
+	}
+	cfg.normalize()
+	return cfg, nil
+}
+
+// normalize canonicalizes case-insensitive values; invalid values are kept
+// as-is so validation can report them.
+func (c PowerUpsLoader) normalize() {
+	for i := range c.PowerUps {
+		p := &c.PowerUps[i]
+		for j, r := range p.Eligible.Roles {
+			p.Eligible.Roles[j] = strings.ToLower(strings.TrimSpace(r))
+		}
+		for j := range p.Hooks {
+			p.Hooks[j] = p.Hooks[j].Normalized()
+		}
+		if p.Ability != nil && p.Ability.DamageType != "" {
+			if dt, err := units.ParseDamageType(string(p.Ability.DamageType)); err == nil {
+				p.Ability.DamageType = dt
+			}
+		}
+	}
+}
+
+// Lookup finds a power-up by name (case-insensitive, surrounding spaces ignored).
+func (c PowerUpsLoader) Lookup(name string) (PowerUp, bool) {
+	n := strings.TrimSpace(name)
+	for _, p := range c.PowerUps {
+		if strings.EqualFold(p.Name, n) {
+			return p, true
+		}
+	}
+	return PowerUp{}, false
+}
+
+// Names returns every power-up name in config order.
+func (c PowerUpsLoader) Names() []string {
+	out := make([]string, 0, len(c.PowerUps))
+	for _, p := range c.PowerUps {
+		out = append(out, p.Name)
+	}
+	return out
+}
+
+// For returns the power-ups u may take, in config order.
+func (c PowerUpsLoader) For(u units.Unit) []PowerUp {
+	var out []PowerUp
+	for _, p := range c.PowerUps {
+		if p.Eligible.Check(u) == nil {
+			out = append(out, p)
+		}
+	}
+	return out
+}
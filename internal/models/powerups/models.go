@@ -0,0 +1,59 @@
+package powerups
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Eligibility restricts which champions may take a power-up. Empty lists
+// allow everyone; both lists must match when set.
+type Eligibility struct {
+	Costs []int    `json:"costs,omitempty"` // champion costs (1-5)
+	Roles []string `json:"roles,omitempty"` // role keys ("marksman", "tank"), matched against role labels
+}
+
+// AbilityMod changes a champion's spell. Base and ratio changes apply to
+// spells without a damage formula; the rest applies to every spell.
+type AbilityMod struct {
+	BaseMult   float64          `json:"base_mult,omitempty"`   // fraction added to the base damage of every star (0.2 = +20%)
+	APRatio    float64          `json:"ap_ratio,omitempty"`    // added to the AP ratio
+	ADRatio    float64          `json:"ad_ratio,omitempty"`    // added to the AD ratio
+	Targets    int              `json:"targets,omitempty"`     // extra targets
+	CastTime   float64          `json:"cast_time,omitempty"`   // seconds added to the cast time (negative shortens it)
+	DamageType units.DamageType `json:"damage_type,omitempty"` // replaces the damage type
+}
+
+// PowerUp is a per-champion bonus (Set 15): one per champion, attached when
+// the unit is built.
+//
+// Stats are flat bonuses and Percent bonuses scale the unit's built value,
+// both applied as stats Options. Hooks run in combat like augment hooks and
+// Ability modifies the champion's spell.
+type PowerUp struct {
+	Name     string             `json:"name"`
+	Eligible Eligibility        `json:"eligible"`
+	Stats    map[string]float64 `json:"stats,omitempty"`
+	Percent  map[string]float64 `json:"percent,omitempty"`
+	Hooks    []augments.Hook    `json:"hooks,omitempty"`
+	Ability  *AbilityMod        `json:"ability,omitempty"`
+}
+
+// Apply returns a with the modifier applied.
+func (m AbilityMod) Apply(a abilities.Ability) abilities.Ability {
+	if m.BaseMult != 0 {
+		base := make([]float64, len(a.Base))
+		for i, v := range a.Base {
+			base[i] = v * (1 + m.BaseMult)
+		}
+		a.Base = base
+	}
+	a.APRatio += m.APRatio
+	a.ADRatio += m.ADRatio
+	a.Targets += m.Targets
+	a.CastTime = max(a.CastTime+m.CastTime, 0)
+	if m.DamageType != "" {
+		a.DamageType = m.DamageType
+	}
+	return a
+}
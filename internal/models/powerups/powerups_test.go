@@ -0,0 +1,108 @@
+package powerups
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func loadSet15(t *testing.T) PowerUpsLoader {
+	t.Helper()
+	cfg, err := LoadPowerUps("../../config/set15/powerups.json")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	roles, err := units.LoadRoles("../../config/set15/roles.json")
+	if err != nil {
+		t.Fatalf("load roles: %v", err)
+	}
+	if err := ValidatePowerUpsConfig(cfg, roles); err != nil {
+		t.Fatalf("set15 power-ups invalid: %v", err)
+	}
+	return cfg
+}
+
+func marksman(t *testing.T, cost int) units.Unit {
+	t.Helper()
+	st, err := units.NewStats(units.WithAD(60), units.WithAS(0.8), units.WithRange(4))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	return units.Unit{Name: "Jinx", Cost: cost, Roles: []string{"Attack Marksman"}, Stats: st}
+}
+
+func TestAttach_EligibilityAndStats(t *testing.T) {
+	t.Parallel()
+	cfg := loadSet15(t)
+	ballistic, _ := cfg.Lookup("ballistic")
+	u, err := Attach(marksman(t, 4), ballistic)
+	if err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+	if as := u.Stats.Offense.AS; as < 0.919 || as > 0.921 {
+		t.Fatalf("AS = %v, want 0.8 × 1.15 = 0.92", as)
+	}
+	if u.Stats.Offense.Range != 5 || u.PowerUp != "Ballistic" {
+		t.Fatalf("range %v, power-up %q", u.Stats.Offense.Range, u.PowerUp)
+	}
+	if _, err := Attach(u, ballistic); err == nil {
+		t.Fatalf("expected an error for a second power-up")
+	}
+
+	tank, _ := cfg.Lookup("Tiny Tank")
+	if _, err := Attach(marksman(t, 4), tank); err == nil || !strings.Contains(err.Error(), "roles") {
+		t.Fatalf("a marksman may not take Tiny Tank, got %v", err)
+	}
+	slayer, _ := cfg.Lookup("Giant Slayer Training")
+	if _, err := Attach(marksman(t, 4), slayer); err == nil || !strings.Contains(err.Error(), "cost") {
+		t.Fatalf("a 4-cost may not take Giant Slayer Training, got %v", err)
+	}
+	for _, p := range cfg.For(marksman(t, 2)) {
+		if p.Name == "Tiny Tank" || p.Name == "Split Shot" {
+			t.Fatalf("%s offered to a 2-cost marksman", p.Name)
+		}
+	}
+}
+
+func TestAbilityMod_Apply(t *testing.T) {
+	t.Parallel()
+	a := abilities.Ability{Name: "Bolt", DamageType: units.Magic, Base: []float64{100, 200, 300}, APRatio: 1, Targets: 1, CastTime: 0.5}
+	got := AbilityMod{BaseMult: 0.5, APRatio: 0.2, Targets: 1, CastTime: -1, DamageType: units.True}.Apply(a)
+	if got.Base[1] != 300 || got.APRatio != 1.2 || got.Targets != 2 || got.CastTime != 0 || got.DamageType != units.True {
+		t.Fatalf("modified ability = %+v", got)
+	}
+	if a.Base[1] != 200 {
+		t.Fatalf("Apply changed the original base damage")
+	}
+}
+
+func TestValidatePowerUpsConfig_ReportsIssues(t *testing.T) {
+	t.Parallel()
+	cfg := PowerUpsLoader{PowerUps: []PowerUp{
+		{Name: "A", Eligible: Eligibility{Costs: []int{6}, Roles: []string{"marksmn"}}, Stats: map[string]float64{"atack_damage": 1}},
+		{Name: "B", Hooks: []augments.Hook{{Trigger: augments.Every, Action: augments.ActionHeal, Amount: 5}}},
+		{Name: "C", Ability: &AbilityMod{BaseMult: -2}},
+		{Name: "D"},
+		{Name: "a", Stats: map[string]float64{"AD": 1}},
+	}}
+	err := ValidatePowerUpsConfig(cfg, units.RolesLoader{})
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	for _, want := range []string{
+		"A.eligible.costs: must be 1-5",
+		`A.eligible.roles: unknown role "marksmn" (did you mean "marksman"?)`,
+		"A.stats.atack_damage: unknown stat",
+		"B.hooks[0]: invalid hook",
+		"C.ability.base_mult",
+		"D.power-up grants nothing",
+		"a: duplicate power-up",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
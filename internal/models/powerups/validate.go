@@ -0,0 +1,104 @@
+package powerups
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/suggest"
+)
+
+// ValidatePowerUpsConfig checks the whole catalog and reports all issues at
+// once, each prefixed by the power-up name. Eligible roles are checked
+// against the role keys of the roles config.
+func ValidatePowerUpsConfig(cfg PowerUpsLoader, roles units.RolesLoader) error {
+	var issues []string
+	seen := map[string]struct{}{}
+	roleKeys := slices.Sorted(maps.Keys(roles.ValidRoleKeys()))
+	for i, p := range cfg.PowerUps {
+		key := strings.ToLower(strings.TrimSpace(p.Name))
+		if key == "" {
+			issues = append(issues, fmt.Sprintf("power_ups[%d]: empty name", i))
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			issues = append(issues, p.Name+": duplicate power-up")
+		}
+		seen[key] = struct{}{}
+		for _, msg := range p.validate() {
+			issues = append(issues, p.Name+"."+msg)
+		}
+		for _, r := range p.Eligible.Roles {
+			if !slices.Contains(roleKeys, strings.ToLower(r)) {
+				hint, _ := suggest.Closest(r, roleKeys)
+				issues = append(issues, fmt.Sprintf("%s.eligible.roles: unknown role %q%s", p.Name, r, suggest.DidYouMean(hint)))
+			}
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("power-ups config validation issues: %v", issues)
+}
+
+// Validate checks a single power-up on its own (roles are checked against the
+// roles config by ValidatePowerUpsConfig).
+func (p PowerUp) Validate() error {
+	if issues := p.validate(); len(issues) > 0 {
+		return fmt.Errorf("invalid power-up %q: %v", p.Name, issues)
+	}
+	return nil
+}
+
+func (p PowerUp) validate() []string {
+	var issues []string
+	for _, c := range p.Eligible.Costs {
+		if c < 1 || c > 5 {
+			issues = append(issues, fmt.Sprintf("eligible.costs: must be 1-5, got %d", c))
+		}
+	}
+	for field, m := range map[string]map[string]float64{"stats": p.Stats, "percent": p.Percent} {
+		for k, v := range m {
+			if _, ok := units.ResolveStatPath(k); !ok {
+				hint, _ := suggest.Closest(k, units.StatPaths())
+				issues = append(issues, fmt.Sprintf("%s.%s: unknown stat%s", field, k, suggest.DidYouMean(hint)))
+			}
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				issues = append(issues, fmt.Sprintf("%s.%s: must be finite, got %v", field, k, v))
+			}
+		}
+	}
+	for i, h := range p.Hooks {
+		if err := h.Validate(); err != nil {
+			issues = append(issues, fmt.Sprintf("hooks[%d]: %v", i, err))
+		}
+	}
+	if m := p.Ability; m != nil {
+		for field, v := range map[string]float64{"base_mult": m.BaseMult, "ap_ratio": m.APRatio, "ad_ratio": m.ADRatio, "cast_time": m.CastTime} {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				issues = append(issues, fmt.Sprintf("ability.%s: must be finite, got %v", field, v))
+			}
+		}
+		if m.BaseMult <= -1 {
+			issues = append(issues, fmt.Sprintf("ability.base_mult: must be > -1, got %v", m.BaseMult))
+		}
+		if m.Targets < 0 {
+			issues = append(issues, fmt.Sprintf("ability.targets: must be >= 0, got %d", m.Targets))
+		}
+		if m.DamageType != "" {
+			if _, err := units.ParseDamageType(string(m.DamageType)); err != nil {
+				issues = append(issues, "ability.damage_type: "+err.Error())
+			}
+		}
+	}
+	if len(p.Stats)+len(p.Percent)+len(p.Hooks) == 0 && p.Ability == nil {
+		issues = append(issues, "power-up grants nothing")
+	}
+	sort.Strings(issues)
+	return issues
+}
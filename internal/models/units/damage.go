@@ -0,0 +1,30 @@
+package units
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DamageType is how a damage instance is mitigated:
+// physical by Armor, magic by MR, true ignores both.
+type DamageType string
+
+const (
+	Physical DamageType = "physical"
+	Magic    DamageType = "magic"
+	True     DamageType = "true"
+)
+
+// ParseDamageType accepts the canonical names case-insensitively.
+func ParseDamageType(s string) (DamageType, error) {
+	switch DamageType(strings.ToLower(strings.TrimSpace(s))) {
+	case Physical:
+		return Physical, nil
+	case Magic:
+		return Magic, nil
+	case True:
+		return True, nil
+	default:
+		return "", fmt.Errorf("invalid damage type %q: must be one of physical, magic, true", s)
+	}
+}
@@ -0,0 +1,16 @@
+package units
+
+import "testing"
+
+func TestParseDamageType(t *testing.T) {
+	t.Parallel()
+	for in, want := range map[string]DamageType{"physical": Physical, " Magic ": Magic, "TRUE": True} {
+		got, err := ParseDamageType(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseDamageType(%q)=(%q,%v) want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseDamageType("attack"); err == nil {
+		t.Fatalf("expected error for role damage token 'attack'")
+	}
+}
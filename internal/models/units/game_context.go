@@ -0,0 +1,54 @@
+package units
+
+import "fmt"
+
+// Game bounds for GameContext validation.
+const (
+	MinStage       = 1
+	MaxStage       = 7
+	RoundsPerStage = 7 // stage 1 has fewer, later stages have 7
+	MinLevel       = 1
+	MaxLevel       = 10
+)
+
+// GameContext is where in the game a unit is built or a fight happens.
+// The zero value means "no context": stage scaling is skipped.
+type GameContext struct {
+	Stage int `json:"stage"` // 1..7
+	Round int `json:"round"` // 1..7 within the stage (0 → 1)
+	Level int `json:"level"` // player level 1..10 (0 → unset)
+}
+
+// IsZero reports whether no stage was chosen.
+func (g GameContext) IsZero() bool { return g.Stage == 0 }
+
+// Validate checks the context is within game bounds.
+func (g GameContext) Validate() error {
+	if g.IsZero() {
+		return nil
+	}
+	if g.Stage < MinStage || g.Stage > MaxStage {
+		return fmt.Errorf("stage must be in [%d,%d], got %d", MinStage, MaxStage, g.Stage)
+	}
+	if g.Round < 0 || g.Round > RoundsPerStage {
+		return fmt.Errorf("round must be in [1,%d], got %d", RoundsPerStage, g.Round)
+	}
+	if g.Level != 0 && (g.Level < MinLevel || g.Level > MaxLevel) {
+		return fmt.Errorf("level must be in [%d,%d], got %d", MinLevel, MaxLevel, g.Level)
+	}
+	return nil
+}
+
+// StageProgress is the stage as a continuous value: stage 4 round 1 → 4.0,
+// round 7 → 4.86. Stage tables interpolate on it.
+func (g GameContext) StageProgress() float64 {
+	round := max(g.Round, 1)
+	return float64(g.Stage) + float64(round-1)/RoundsPerStage
+}
+
+func (g GameContext) String() string {
+	if g.IsZero() {
+		return "no stage"
+	}
+	return fmt.Sprintf("%d-%d", g.Stage, max(g.Round, 1))
+}
@@ -11,4 +11,6 @@ type Unit struct {
 	Traits []string  `json:"traits"`
 	Roles  []string  `json:"roles"`
 	Stats  Stats     `json:"stats"`
+
+	PowerUp string `json:"power_up,omitempty"` // attached power-up (see package powerups)
 }
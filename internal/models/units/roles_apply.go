@@ -3,24 +3,59 @@ package units
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
 )
 
 // ApplyReport collects issues encountered while applying a JSON-like map onto Stats.
 type ApplyReport struct {
-	UnknownKeys []string // keys with no matching JSON tag in the target struct
-	TypeErrors  []string // "path.to.key: expected <type>, got <actual>"
+	UnknownKeys []string          // keys with no matching JSON tag in the target struct
+	TypeErrors  []string          // "path.to.key: expected <type>, got <actual>"
+	Suggestions map[string]string // unknown key path → closest valid path (typo hints)
+	Warnings    []string          // non-fatal: deprecated aliases, ignored duplicate spellings
 }
 
+// empty reports whether there are issues; Warnings never make a report non-empty.
 func (r *ApplyReport) empty() bool {
 	return len(r.UnknownKeys) == 0 && len(r.TypeErrors) == 0
 }
 
+func (r *ApplyReport) appendWarning(msg string) {
+	r.Warnings = append(r.Warnings, msg)
+}
+
 func (r *ApplyReport) appendUnknown(path string) {
 	r.UnknownKeys = append(r.UnknownKeys, path)
 }
 
+func (r *ApplyReport) suggest(path, suggestion string) {
+	if r.Suggestions == nil {
+		r.Suggestions = make(map[string]string)
+	}
+	r.Suggestions[path] = suggestion
+}
+
+// unknownKeysWithHints renders UnknownKeys with their "did you mean" hint, if any.
+func (r *ApplyReport) unknownKeysWithHints() []string {
+	return withHints(r.UnknownKeys, r.Suggestions, "")
+}
+
+// withHints renders keys (optionally prefixed) with the suggestion recorded for each.
+func withHints(keys []string, suggestions map[string]string, prefix string) []string {
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		hint := ""
+		if s, ok := suggestions[k]; ok {
+			hint = prefix + s
+		}
+		out = append(out, prefix+k+didYouMean(hint))
+	}
+	return out
+}
+
 func (r *ApplyReport) appendTypeErr(path, expected, got string) {
 	r.TypeErrors = append(r.TypeErrors, fmt.Sprintf("%s: expected %s, got %s", path, expected, got))
 }
@@ -37,8 +72,9 @@ func applyRoleMapToStats(dst *Stats, roleDoc map[string]any) ApplyReport {
 }
 
 // applyJSONMapByTags recursively traverses a struct and assigns fields from m by JSON tag.
+// - Keys are normalized (camelCase/kebab-case → snake_case) and aliases resolved (ad → attack_damage).
 // - Supports bools and numbers (float64/int/float32 → float64).
-// - Reports unknown keys and type mismatches.
+// - Reports unknown keys and type mismatches; deprecated aliases only warn.
 func applyJSONMapByTags(structV reflect.Value, m map[string]any, report *ApplyReport, path string) {
 	if structV.Kind() != reflect.Struct {
 		return
@@ -58,18 +94,53 @@ func applyJSONMapByTags(structV reflect.Value, m map[string]any, report *ApplyRe
 		tagIndex[tag] = i
 	}
 
-	for rawKey, rawVal := range m {
-		key := strings.ToLower(rawKey)
-
-		idx, ok := tagIndex[key]
-		curPath := key
-		if path != "" {
-			curPath = path + "." + key
-		}
+	// Resolve spellings first, then apply in a fixed order (exact tags, then
+	// reformatted tags, then aliases; ties by raw key) so duplicates resolve
+	// the same way on every run.
+	type entry struct {
+		raw, key string
+		rank     int
+	}
+	entries := make([]entry, 0, len(m))
+	for _, rawKey := range slices.Sorted(maps.Keys(m)) {
+		normalized, tag, aliased, deprecated, ok := resolveStatKey(rawKey, tagIndex)
 		if !ok {
+			curPath := joinPath(path, normalized)
 			report.appendUnknown(curPath)
+			if hint, ok := closestMatch(normalized, mapKeys(tagIndex)); ok {
+				report.suggest(curPath, joinPath(path, hint))
+			}
+			continue
+		}
+		if deprecated {
+			report.appendWarning(fmt.Sprintf("%s: deprecated alias, use %s", joinPath(path, normalized), joinPath(path, tag)))
+		}
+		rank := 0
+		switch {
+		case aliased:
+			rank = 2
+		case rawKey != tag:
+			rank = 1
+		}
+		entries = append(entries, entry{raw: rawKey, key: tag, rank: rank})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].rank != entries[j].rank {
+			return entries[i].rank < entries[j].rank
+		}
+		return entries[i].raw < entries[j].raw
+	})
+
+	assigned := make(map[string]string, len(entries))
+	for _, e := range entries {
+		key, rawVal := e.key, m[e.raw]
+		curPath := joinPath(path, key)
+		if first, dup := assigned[key]; dup {
+			report.appendWarning(fmt.Sprintf("%s: %q ignored, already set by %q", curPath, e.raw, first))
 			continue
 		}
+		assigned[key] = e.raw
+		idx := tagIndex[key]
 		fieldV := structV.Field(idx)
 		fieldT := fieldV.Type()
 
@@ -103,6 +174,13 @@ func applyJSONMapByTags(structV reflect.Value, m map[string]any, report *ApplyRe
 	}
 }
 
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
 func tagBase(tag string) string {
 	if tag == "" {
 		return ""
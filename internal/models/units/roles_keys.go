@@ -0,0 +1,75 @@
+package units
+
+import (
+	"strings"
+	"unicode"
+)
+
+// statKeyAlias maps an alternative spelling onto a canonical JSON tag.
+type statKeyAlias struct {
+	canonical  string
+	deprecated bool // true → accepted with a warning in ApplyReport
+}
+
+// statKeyAliases is keyed by normalized (snake_case) spelling.
+// An alias only applies where its canonical tag exists in the struct being filled.
+var statKeyAliases = map[string]statKeyAlias{
+	// Community shorthand.
+	"ad":          {canonical: "attack_damage"},
+	"base_ad":     {canonical: "base_attack_damage"},
+	"ap":          {canonical: "ability_power"},
+	"as":          {canonical: "attack_speed"},
+	"mr":          {canonical: "magic_resist"},
+	"crit_chance": {canonical: "critical_strike_chance"},
+	"crit_damage": {canonical: "critical_strike_damage"},
+
+	// Legacy spellings from older configs and community data.
+	"health":               {canonical: "hp", deprecated: true},
+	"magic_resistance":     {canonical: "magic_resist", deprecated: true},
+	"damage_amplification": {canonical: "damage_amp", deprecated: true},
+	"mana_regeneration":    {canonical: "mana_regen", deprecated: true},
+	"mana_per_attack":      {canonical: "mana_per_hit", deprecated: true},
+}
+
+// normalizeStatKey folds camelCase, PascalCase, kebab-case and spaced keys to snake_case:
+// "attackDamage", "Attack-Damage", "attack damage" → "attack_damage"; "AD" → "ad".
+func normalizeStatKey(raw string) string {
+	rs := []rune(strings.TrimSpace(raw))
+	var b strings.Builder
+	for i, r := range rs {
+		switch {
+		case r == '-' || r == ' ':
+			r = '_'
+		case unicode.IsUpper(r) && i > 0:
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			// Word boundary: "aB" or the last capital of an acronym before a word ("ADValue").
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	// Collapse runs like "attack__damage" produced by mixed delimiters.
+	out := b.String()
+	for strings.Contains(out, "__") {
+		out = strings.ReplaceAll(out, "__", "_")
+	}
+	return strings.Trim(out, "_")
+}
+
+// resolveStatKey maps a raw config key to a JSON tag present in tags.
+// It returns the normalized key (for reporting), the matched tag, whether an
+// alias was used, and whether that alias is deprecated.
+func resolveStatKey[V any](raw string, tags map[string]V) (normalized, tag string, aliased, deprecated, ok bool) {
+	normalized = normalizeStatKey(raw)
+	if _, found := tags[normalized]; found {
+		return normalized, normalized, false, false, true
+	}
+	if a, found := statKeyAliases[normalized]; found {
+		if _, found := tags[a.canonical]; found {
+			return normalized, a.canonical, true, a.deprecated, true
+		}
+	}
+	return normalized, "", false, false, false
+}
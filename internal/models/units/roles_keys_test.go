@@ -0,0 +1,97 @@
+package units
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeStatKey(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"attack_damage":     "attack_damage",
+		"attackDamage":      "attack_damage",
+		"AttackDamage":      "attack_damage",
+		"attack-damage":     "attack_damage",
+		"Attack Damage":     "attack_damage",
+		"AD":                "ad",
+		"baseAD":            "base_ad",
+		"ADValue":           "ad_value",
+		"manaFromDamage":    "mana_from_damage",
+		" mana--per-hit ":   "mana_per_hit",
+		"perInstanceCap":    "per_instance_cap",
+		"OmnivampMin":       "omnivamp_min",
+		"critical_strike_2": "critical_strike_2",
+	}
+	for in, want := range cases {
+		if got := normalizeStatKey(in); got != want {
+			t.Fatalf("normalizeStatKey(%q)=%q want %q", in, got, want)
+		}
+	}
+}
+
+func TestApplyRoleMapToStats_AliasesAndFormats(t *testing.T) {
+	t.Parallel()
+	var dst Stats
+	report := applyRoleMapToStats(&dst, map[string]any{
+		"Offense": map[string]any{
+			"ad":         55.0,
+			"AS":         0.7,
+			"critChance": 0.3, // camelCase alias
+		},
+		"defense": map[string]any{
+			"magic-resist":   40.0,
+			"targetPriority": 1.0,
+			"health":         700.0, // deprecated alias
+		},
+		"resource": map[string]any{
+			"manaFromDamage": map[string]any{"per-instance-cap": 42.5},
+		},
+	})
+	if len(report.UnknownKeys) != 0 || len(report.TypeErrors) != 0 {
+		t.Fatalf("aliases/formats should resolve cleanly: %+v", report)
+	}
+	o, d := dst.Offense, dst.Defense
+	if o.AD != 55 || o.AS != 0.7 || o.CritChance != 0.3 || d.MR != 40 || d.TargetPriority != 1 || d.HP != 700 {
+		t.Fatalf("unexpected stats: %+v", dst)
+	}
+	if dst.Resource.ManaFromDamage.PerInstanceCap != 42.5 {
+		t.Fatalf("nested camel/kebab keys not applied: %+v", dst.Resource)
+	}
+	if len(report.Warnings) != 1 || !strings.Contains(report.Warnings[0], "defense.health: deprecated alias, use defense.hp") {
+		t.Fatalf("expected one deprecation warning, got %v", report.Warnings)
+	}
+}
+
+func TestApplyRoleMapToStats_DuplicateSpellings_Deterministic(t *testing.T) {
+	t.Parallel()
+	for i := 0; i < 20; i++ {
+		var dst Stats
+		report := applyRoleMapToStats(&dst, map[string]any{
+			"offense": map[string]any{"ad": 10.0, "attack_damage": 20.0, "attackDamage": 30.0},
+		})
+		if dst.Offense.AD != 20 {
+			t.Fatalf("exact tag must win over other spellings, got %v", dst.Offense.AD)
+		}
+		if len(report.Warnings) != 2 || !report.empty() {
+			t.Fatalf("expected 2 ignored-duplicate warnings and no errors, got %+v", report)
+		}
+	}
+}
+
+func TestStatsForRole_StrictMode_DeprecatedAliasIsNotAnError(t *testing.T) {
+	t.Parallel()
+	cfg := RolesLoader{
+		Strict:    true,
+		RoleTypes: []string{"Caster"},
+		StatsPerRoles: map[string]any{
+			"caster": map[string]any{"resource": map[string]any{"mana_regeneration": 2.0}},
+		},
+	}
+	s, err := StatsForRole("Caster", cfg, WithRange(1))
+	if err != nil {
+		t.Fatalf("deprecated alias must not fail strict mode: %v", err)
+	}
+	if s.Resource.ManaRegen != 2 {
+		t.Fatalf("alias value not applied: %+v", s.Resource)
+	}
+}
@@ -2,6 +2,7 @@ package units
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 
@@ -17,6 +18,7 @@ type RolesLoader struct {
 	RoleTypes     []string       `json:"role_type"`   // NEW
 	DamageTypes   []string       `json:"damage_type"` // NEW
 	Strict        bool           `json:"-"`           // runtime-only
+	Logger        *slog.Logger   `json:"-"`           // runtime-only; nil → slog.Default()
 }
 
 func LoadRoles(path string) (RolesLoader, error) {
@@ -32,6 +34,15 @@ func LoadRoles(path string) (RolesLoader, error) {
 	return cfg, nil
 }
 
+// logger returns the configured logger scoped to the roles component.
+func (c RolesLoader) logger() *slog.Logger {
+	l := c.Logger
+	if l == nil {
+		l = slog.Default()
+	}
+	return l.With("component", "roles")
+}
+
 // Lowercases and returns a set for fast membership checks.
 // Falls back to built-in lists if the JSON list is empty.
 func (c RolesLoader) ValidRoleKeys() map[string]struct{} {
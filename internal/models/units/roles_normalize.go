@@ -2,14 +2,14 @@ package units
 
 import "strings"
 
-// detectRoleKey parses a free-form label into (roleKey, damageType, ok).
-// It now receives allowed tokens from the caller (data-driven).
-func detectRoleKey(raw string, validRoles, damageTokens map[string]struct{}) (string, string, bool) {
+// RoleTokens splits a role label into lowercase tokens ("Attack Marksman" →
+// ["attack", "marksman"]), the way role labels are parsed.
+func RoleTokens(label string) []string { return roleLabelTokens(label) }
+
+// roleLabelTokens lowercases a free-form role label and splits it on delimiters.
+func roleLabelTokens(raw string) []string {
 	s := strings.ToLower(strings.TrimSpace(raw))
-	if s == "" {
-		return "", "", false
-	}
-	tokens := strings.FieldsFunc(s, func(r rune) bool {
+	return strings.FieldsFunc(s, func(r rune) bool {
 		switch r {
 		case ' ', '\t', '-', '_', '/':
 			return true
@@ -17,6 +17,15 @@ func detectRoleKey(raw string, validRoles, damageTokens map[string]struct{}) (st
 			return false
 		}
 	})
+}
+
+// detectRoleKey parses a free-form label into (roleKey, damageType, ok).
+// It now receives allowed tokens from the caller (data-driven).
+func detectRoleKey(raw string, validRoles, damageTokens map[string]struct{}) (string, string, bool) {
+	tokens := roleLabelTokens(raw)
+	if len(tokens) == 0 {
+		return "", "", false
+	}
 
 	var damageType string
 	var roleKey string
@@ -41,3 +50,45 @@ func detectRoleKey(raw string, validRoles, damageTokens map[string]struct{}) (st
 	}
 	return roleKey, damageType, true
 }
+
+// suggestRoleLabel rewrites each unknown token of raw to its closest valid role or
+// damage token ("Atack Tank" → "Attack Tank"). It returns "" when a token has no
+// plausible match or when the rewritten label would still be rejected.
+func suggestRoleLabel(raw string, validRoles, damageTokens map[string]struct{}) string {
+	tokens := roleLabelTokens(raw)
+	if len(tokens) == 0 {
+		return ""
+	}
+	candidates := append(mapKeys(validRoles), mapKeys(damageTokens)...)
+
+	fixed := make([]string, len(tokens))
+	changed := false
+	for i, tok := range tokens {
+		_, isRole := validRoles[tok]
+		_, isDmg := damageTokens[tok]
+		if isRole || isDmg {
+			fixed[i] = tok
+			continue
+		}
+		m, ok := closestMatch(tok, candidates)
+		if !ok {
+			return ""
+		}
+		fixed[i] = m
+		changed = true
+	}
+	label := strings.Join(fixed, " ")
+	if _, _, ok := detectRoleKey(label, validRoles, damageTokens); !ok || !changed {
+		return ""
+	}
+	return titleWords(label)
+}
+
+// titleWords upper-cases the first letter of each space-separated word.
+func titleWords(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
@@ -24,7 +24,8 @@ func ValidateRolesConfig(cfg RolesLoader) error {
 	for rawRole, v := range sp {
 		roleKey := strings.ToLower(rawRole)
 		if _, ok := validRoles[roleKey]; !ok {
-			issues.roleUnknown = append(issues.roleUnknown, rawRole)
+			hint, _ := closestMatch(roleKey, mapKeys(validRoles))
+			issues.roleUnknown = append(issues.roleUnknown, rawRole+didYouMean(hint))
 			continue
 		}
 
@@ -38,9 +39,7 @@ func ValidateRolesConfig(cfg RolesLoader) error {
 		report := applyRoleMapToStats(&dst, roleMap)
 
 		prefix := roleKey + "."
-		for _, k := range report.UnknownKeys {
-			issues.unknownKeys = append(issues.unknownKeys, prefix+k)
-		}
+		issues.unknownKeys = append(issues.unknownKeys, withHints(report.UnknownKeys, report.Suggestions, prefix)...)
 		for _, te := range report.TypeErrors {
 			issues.typeErrors = append(issues.typeErrors, prefix+te)
 		}
@@ -0,0 +1,111 @@
+package units
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+
+	json "encoding/json/v2"
+)
+
+// StagePoint is the value of a stat at a given stage.
+type StagePoint struct {
+	Stage float64 `json:"stage"`
+	Value float64 `json:"value"`
+}
+
+// StagesLoader holds stage-dependent stat tables, keyed by stat path
+// ("offense.omnivamp.current_omnivamp"). Points are in ascending stage order.
+type StagesLoader struct {
+	Scaling map[string][]StagePoint `json:"stage_scaling"`
+}
+
+func LoadStages(path string) (StagesLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return StagesLoader{}, fmt.Errorf("read stages config: %w", err)
+	}
+	var cfg StagesLoader
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return StagesLoader{}, fmt.Errorf("parse stages config: %w", err)
+	}
+	return cfg, nil
+}
+
+// ValidateStagesConfig checks every key is a stat path and every table has
+// finite points in strictly ascending stage order.
+func ValidateStagesConfig(cfg StagesLoader) error {
+	var issues []string
+	for key, points := range cfg.Scaling {
+		if path, ok := ResolveStatPath(key); !ok || path != key {
+			hint := path
+			if !ok {
+				hint, _ = closestMatch(key, StatPaths())
+			}
+			issues = append(issues, fmt.Sprintf("%s: unknown stat path%s", key, didYouMean(hint)))
+			continue
+		}
+		if len(points) == 0 {
+			issues = append(issues, fmt.Sprintf("%s: no points", key))
+		}
+		for i, p := range points {
+			if anyNonFinite(p.Stage, p.Value) {
+				issues = append(issues, fmt.Sprintf("%s[%d]: non-finite point", key, i))
+				break
+			}
+			if i > 0 && p.Stage <= points[i-1].Stage {
+				issues = append(issues, fmt.Sprintf("%s: stages must be strictly ascending", key))
+				break
+			}
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("stages config validation issues: %v", issues)
+}
+
+// ValueAt interpolates a table linearly at the given stage progress, holding
+// the first/last value outside the table.
+func ValueAt(points []StagePoint, stage float64) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+	if stage <= points[0].Stage {
+		return points[0].Value
+	}
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		if stage <= b.Stage {
+			t := (stage - a.Stage) / (b.Stage - a.Stage)
+			return a.Value + t*(b.Value-a.Value)
+		}
+	}
+	return points[len(points)-1].Value
+}
+
+// Apply sets every stage-scaled stat of s for ctx, then sanitizes (e.g.
+// current omnivamp is clamped to the unit's [min,max]: units without an
+// omnivamp range stay at 0). A zero ctx returns s unchanged.
+func (c StagesLoader) Apply(s Stats, ctx GameContext) (Stats, error) {
+	if ctx.IsZero() {
+		return s, nil
+	}
+	if err := ctx.Validate(); err != nil {
+		return Stats{}, err
+	}
+	keys := make([]string, 0, len(c.Scaling))
+	for k := range c.Scaling {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys) // deterministic order
+	progress := ctx.StageProgress()
+	for _, k := range keys {
+		if !s.SetValue(k, ValueAt(c.Scaling[k], progress)) {
+			return Stats{}, fmt.Errorf("stage scaling: unknown stat path %q", k)
+		}
+	}
+	return s.With()
+}
@@ -0,0 +1,85 @@
+package units
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func loadSet15(t *testing.T) (RolesLoader, StagesLoader) {
+	t.Helper()
+	roles, err := LoadRoles("../../config/set15/roles.json")
+	if err != nil {
+		t.Fatalf("roles: %v", err)
+	}
+	stages, err := LoadStages("../../config/set15/stages.json")
+	if err != nil {
+		t.Fatalf("stages: %v", err)
+	}
+	if err := ValidateStagesConfig(stages); err != nil {
+		t.Fatalf("set15 stages config invalid: %v", err)
+	}
+	return roles, stages
+}
+
+func TestStages_FighterOmnivampScalesWithStage(t *testing.T) {
+	t.Parallel()
+	roles, stages := loadSet15(t)
+
+	omni := func(role string, ctx GameContext) float64 {
+		t.Helper()
+		f := NewUnitFactory(roles, WithGameContext(ctx, stages))
+		u, err := f.Build("X", 1, nil, []string{role}, WithRange(1))
+		if err != nil {
+			t.Fatalf("build %s at %s: %v", role, ctx, err)
+		}
+		return u.Stats.Offense.Omnivamp.CurrentOmnivamp
+	}
+	cases := []struct {
+		ctx  GameContext
+		want float64
+	}{
+		{GameContext{}, 0.08}, // no context: role default
+		{GameContext{Stage: 1, Round: 2}, 0.08},
+		{GameContext{Stage: 2, Round: 1}, 0.08},
+		{GameContext{Stage: 3, Round: 1}, 0.12},
+		{GameContext{Stage: 4, Round: 4}, 0.16 + 0.04*3.0/7},
+		{GameContext{Stage: 5, Round: 1}, 0.20},
+		{GameContext{Stage: 7, Round: 3}, 0.20},
+	}
+	for _, c := range cases {
+		if got := omni("Attack Fighter", c.ctx); math.Abs(got-c.want) > 1e-9 {
+			t.Fatalf("fighter at %s: got %v want %v", c.ctx, got, c.want)
+		}
+	}
+	// Non-fighters have no omnivamp range: the table value clamps to 0.
+	if got := omni("Attack Tank", GameContext{Stage: 5}); got != 0 {
+		t.Fatalf("tank omnivamp should stay 0, got %v", got)
+	}
+}
+
+func TestStages_InvalidContext(t *testing.T) {
+	t.Parallel()
+	roles, stages := loadSet15(t)
+	f := NewUnitFactory(roles, WithGameContext(GameContext{Stage: 9}, stages))
+	if _, err := f.Build("X", 1, nil, []string{"Attack Fighter"}, WithRange(1)); err == nil || !strings.Contains(err.Error(), "stage must be") {
+		t.Fatalf("expected a stage bounds error, got %v", err)
+	}
+}
+
+func TestValidateStagesConfig_Issues(t *testing.T) {
+	t.Parallel()
+	cfg := StagesLoader{Scaling: map[string][]StagePoint{
+		"offense.ability_powr":  {{Stage: 2, Value: 1}},
+		"offense.attack_damage": {{Stage: 3, Value: 1}, {Stage: 2, Value: 2}},
+	}}
+	err := ValidateStagesConfig(cfg)
+	if err == nil {
+		t.Fatalf("expected issues")
+	}
+	for _, want := range []string{`did you mean "offense.ability_power"`, "strictly ascending"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
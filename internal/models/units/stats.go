@@ -37,6 +37,8 @@ type DefenseStats struct {
 	Armor      float64 `json:"armor"`
 	MR         float64 `json:"magic_resist"`
 	Durability float64 `json:"durability"`
+	// Tenacity shortens incoming crowd control: duration × (1 - tenacity), in [0,1].
+	Tenacity float64 `json:"tenacity"`
 	// TargetPriority is a tie-breaker bias in [-1,+1]:
 	// -1 = less likely to be targeted, +1 = more likely, 0 = neutral.
 	// Engine should only apply it when distance (and other primary criteria) are tied.
@@ -15,13 +15,14 @@ var defaultStats = Stats{
 			OmnivampMin:     0,
 			OmnivampMax:     0,
 			CurrentOmnivamp: 0,
-		}, // Default for fighter is +8% - +20% based on Stage.
+		}, // Default for fighter is +8% - +20% based on Stage (see stages.json / WithGameContext).
 	},
 	Defense: DefenseStats{
 		HP:             0,
 		Armor:          0,
 		MR:             0,
 		Durability:     0,
+		Tenacity:       0,
 		TargetPriority: 0,
 	},
 	Resource: Resource{
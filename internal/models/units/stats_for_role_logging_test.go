@@ -0,0 +1,76 @@
+package units
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("bad log line %q: %v", line, err)
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+func TestStatsForRole_Logger_WarningAttributes(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	cfg := RolesLoader{
+		RoleTypes:   []string{"Tank", "Fighter"},
+		DamageTypes: []string{"Attack"},
+		StatsPerRoles: map[string]any{
+			"tank": map[string]any{"offense": map[string]any{"unknown": 1.0}},
+		},
+		Logger: slog.New(slog.NewJSONHandler(&buf, nil)),
+	}
+
+	if _, err := StatsForRole("Attack Tank", cfg, WithRange(1)); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if _, err := StatsForRole("Attack Fighter", cfg, WithRange(1)); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	recs := decodeLogLines(t, &buf)
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 warnings, got %d: %s", len(recs), buf.String())
+	}
+	w := recs[0]
+	if w["level"] != "WARN" || w["role"] != "Attack Tank" || w["normalized"] != "tank" || w["component"] != "roles" {
+		t.Fatalf("unexpected override warning attrs: %v", w)
+	}
+	if keys, ok := w["unknown_keys"].([]any); !ok || len(keys) != 1 || keys[0] != "offense.unknown" {
+		t.Fatalf("unknown_keys attr missing/wrong: %v", w["unknown_keys"])
+	}
+	if recs[1]["normalized"] != "fighter" {
+		t.Fatalf("unexpected no-override warning attrs: %v", recs[1])
+	}
+}
+
+func TestUnitFactory_WithLogger_CarriesContext(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	l := slog.New(slog.NewJSONHandler(&buf, nil)).With("request_id", "req-1")
+	f := NewUnitFactory(RolesLoader{RoleTypes: []string{"Tank"}}, WithLogger(l))
+	if _, err := f.Build("Foo", 1, nil, []string{"Tank"}, WithRange(1)); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	recs := decodeLogLines(t, &buf)
+	if len(recs) != 1 || recs[0]["request_id"] != "req-1" {
+		t.Fatalf("expected one warning with request_id, got %s", buf.String())
+	}
+}
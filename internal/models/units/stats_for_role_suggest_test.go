@@ -0,0 +1,74 @@
+package units
+
+import (
+	"strings"
+	"testing"
+)
+
+func suggestCfg() RolesLoader {
+	return RolesLoader{
+		RoleTypes:   []string{"Tank", "Marksman"},
+		DamageTypes: []string{"Attack", "Magic"},
+		StatsPerRoles: map[string]any{
+			"tank": map[string]any{
+				"offense": map[string]any{"atack_speed": 0.7},
+			},
+		},
+	}
+}
+
+func TestStatsForRole_InvalidLabel_SuggestsFix(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"Atack Tank":    `did you mean "Attack Tank"?`,
+		"Marksmen":      `did you mean "Marksman"?`,
+		"magic marksmn": `did you mean "Magic Marksman"?`,
+	}
+	for in, want := range cases {
+		_, err := StatsForRole(in, suggestCfg(), WithRange(1))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%q: expected %q in error, got %v", in, want, err)
+		}
+	}
+
+	_, err := StatsForRole("Wizard", suggestCfg(), WithRange(1))
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Fatalf("no plausible fix must not suggest anything, got %v", err)
+	}
+}
+
+func TestApplyRoleMapToStats_UnknownKeySuggestion(t *testing.T) {
+	t.Parallel()
+	var dst Stats
+	report := applyRoleMapToStats(&dst, map[string]any{
+		"ofense":  map[string]any{},
+		"defense": map[string]any{"armour": 10.0, "zzz": 1.0},
+	})
+	if got := report.Suggestions["defense.armour"]; got != "defense.armor" {
+		t.Fatalf("expected defense.armor hint, got %q (%+v)", got, report.Suggestions)
+	}
+	if got := report.Suggestions["ofense"]; got != "offense" {
+		t.Fatalf("expected offense hint, got %q", got)
+	}
+	if _, ok := report.Suggestions["defense.zzz"]; ok {
+		t.Fatalf("no hint expected for defense.zzz")
+	}
+}
+
+func TestStrictErrors_IncludeKeySuggestions(t *testing.T) {
+	t.Parallel()
+	cfg := suggestCfg()
+	cfg.Strict = true
+	_, err := StatsForRole("Attack Tank", cfg, WithRange(1))
+	if err == nil || !strings.Contains(err.Error(), `offense.atack_speed (did you mean "offense.attack_speed"?)`) {
+		t.Fatalf("expected key hint in strict error, got %v", err)
+	}
+
+	cfg.StatsPerRoles["tnak"] = map[string]any{}
+	err = ValidateRolesConfig(cfg)
+	if err == nil ||
+		!strings.Contains(err.Error(), `tank.offense.atack_speed (did you mean "tank.offense.attack_speed"?)`) ||
+		!strings.Contains(err.Error(), `tnak (did you mean "tank"?)`) {
+		t.Fatalf("expected role and key hints in validation error, got %v", err)
+	}
+}
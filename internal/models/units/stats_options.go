@@ -66,6 +66,7 @@ func WithHP(v float64) Option         { return setDefense(func(d *DefenseStats)
 func WithArmor(v float64) Option      { return setDefense(func(d *DefenseStats) { d.Armor = v }) }
 func WithMR(v float64) Option         { return setDefense(func(d *DefenseStats) { d.MR = v }) }
 func WithDurability(v float64) Option { return setDefense(func(d *DefenseStats) { d.Durability = v }) }
+func WithTenacity(v float64) Option   { return setDefense(func(d *DefenseStats) { d.Tenacity = v }) }
 func WithTargetPriority(v float64) Option {
 	return setDefense(func(d *DefenseStats) { d.TargetPriority = v })
 }
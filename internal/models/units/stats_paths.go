@@ -0,0 +1,123 @@
+package units
+
+import (
+	"reflect"
+	"strings"
+)
+
+// statField is a numeric leaf of Stats addressed by its dotted JSON-tag path.
+type statField struct {
+	path  string // e.g. "offense.omnivamp.current_omnivamp"
+	index []int  // reflect field index path
+}
+
+var (
+	statFields      = collectStatFields(reflect.TypeOf(Stats{}), nil, "")
+	statFieldByPath = indexStatFields(statFields)
+)
+
+func collectStatFields(t reflect.Type, index []int, path string) []statField {
+	var out []statField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := tagBase(sf.Tag.Get("json"))
+		if sf.PkgPath != "" || tag == "" || tag == "-" {
+			continue
+		}
+		idx := append(append([]int(nil), index...), i)
+		switch sf.Type.Kind() {
+		case reflect.Struct:
+			out = append(out, collectStatFields(sf.Type, idx, joinPath(path, tag))...)
+		case reflect.Float64:
+			out = append(out, statField{path: joinPath(path, tag), index: idx})
+		}
+	}
+	return out
+}
+
+func indexStatFields(fields []statField) map[string][]int {
+	m := make(map[string][]int, len(fields))
+	for _, f := range fields {
+		m[f.path] = f.index
+	}
+	return m
+}
+
+// StatPaths returns the dotted JSON-tag path of every numeric stat, in struct order.
+func StatPaths() []string {
+	out := make([]string, len(statFields))
+	for i, f := range statFields {
+		out[i] = f.path
+	}
+	return out
+}
+
+// ResolveStatPath maps a full path ("offense.ability_power"), a bare tag
+// ("ability_power") or an alias ("AP") in any casing/format to its full path.
+func ResolveStatPath(name string) (string, bool) {
+	segs := strings.Split(strings.TrimSpace(name), ".")
+	for i, seg := range segs {
+		segs[i] = normalizeStatKey(seg)
+	}
+	last := segs[len(segs)-1]
+	candidates := []string{last}
+	if a, ok := statKeyAliases[last]; ok {
+		candidates = append(candidates, a.canonical)
+	}
+
+	for _, leaf := range candidates {
+		if len(segs) > 1 {
+			full := strings.Join(append(segs[:len(segs)-1:len(segs)-1], leaf), ".")
+			if _, ok := statFieldByPath[full]; ok {
+				return full, true
+			}
+			continue
+		}
+		// Bare tag: accept only when exactly one leaf carries it.
+		match := ""
+		for _, f := range statFields {
+			if f.path == leaf || strings.HasSuffix(f.path, "."+leaf) {
+				if match != "" {
+					return "", false
+				}
+				match = f.path
+			}
+		}
+		if match != "" {
+			return match, true
+		}
+	}
+	return "", false
+}
+
+// Value reads a numeric stat by full path (see StatPaths).
+func (s Stats) Value(path string) (float64, bool) {
+	idx, ok := statFieldByPath[path]
+	if !ok {
+		return 0, false
+	}
+	return reflect.ValueOf(s).FieldByIndex(idx).Float(), true
+}
+
+// SetValue writes a numeric stat by full path without sanitizing;
+// callers go through With/normalized when invariants matter.
+func (s *Stats) SetValue(path string, v float64) bool {
+	idx, ok := statFieldByPath[path]
+	if !ok || s == nil {
+		return false
+	}
+	reflect.ValueOf(s).Elem().FieldByIndex(idx).SetFloat(v)
+	return true
+}
+
+// StatAliases returns every alias spelling that resolves to a stat, mapped to
+// its full path (e.g. "ad" → "offense.attack_damage").
+func StatAliases() map[string]string {
+	out := make(map[string]string, len(statKeyAliases))
+	for alias := range statKeyAliases {
+		if full, ok := ResolveStatPath(alias); ok {
+			out[alias] = full
+		}
+	}
+	return out
+}
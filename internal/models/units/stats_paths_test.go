@@ -0,0 +1,72 @@
+package units
+
+import "testing"
+
+func TestStatPaths_CoverNumericLeaves(t *testing.T) {
+	t.Parallel()
+	paths := StatPaths()
+	want := map[string]bool{
+		"offense.attack_damage":                      false,
+		"offense.omnivamp.current_omnivamp":          false,
+		"defense.magic_resist":                       false,
+		"resource.mana_from_damage.per_instance_cap": false,
+	}
+	for _, p := range paths {
+		if _, ok := want[p]; ok {
+			want[p] = true
+		}
+		if p == "resource.mana_from_damage.enabled" {
+			t.Fatalf("bool fields are not numeric stat paths")
+		}
+	}
+	for p, seen := range want {
+		if !seen {
+			t.Fatalf("missing path %q in %v", p, paths)
+		}
+	}
+}
+
+func TestResolveStatPath(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"offense.ability_power": "offense.ability_power",
+		"ability_power":         "offense.ability_power",
+		"AP":                    "offense.ability_power",
+		"AD":                    "offense.attack_damage",
+		"defense.MR":            "defense.magic_resist",
+		"currentOmnivamp":       "offense.omnivamp.current_omnivamp",
+		"Defense.Armor":         "defense.armor",
+	}
+	for in, want := range cases {
+		if got, ok := ResolveStatPath(in); !ok || got != want {
+			t.Fatalf("ResolveStatPath(%q)=(%q,%v) want %q", in, got, ok, want)
+		}
+	}
+	for _, bad := range []string{"offense.armor", "nope", "offense"} {
+		if got, ok := ResolveStatPath(bad); ok {
+			t.Fatalf("ResolveStatPath(%q) should fail, got %q", bad, got)
+		}
+	}
+}
+
+func TestStats_ValueAndSetValue(t *testing.T) {
+	t.Parallel()
+	s := build(t, WithAP(40))
+	if v, ok := s.Value("offense.ability_power"); !ok || v != 40 {
+		t.Fatalf("Value: got (%v,%v)", v, ok)
+	}
+	if !s.SetValue("defense.armor", 55) || s.Defense.Armor != 55 {
+		t.Fatalf("SetValue did not write armor: %+v", s.Defense)
+	}
+	if s.SetValue("defense.nope", 1) {
+		t.Fatalf("SetValue must reject unknown paths")
+	}
+}
+
+func TestStatAliases(t *testing.T) {
+	t.Parallel()
+	a := StatAliases()
+	if a["ad"] != "offense.attack_damage" || a["mr"] != "defense.magic_resist" || a["health"] != "defense.hp" {
+		t.Fatalf("unexpected aliases: %v", a)
+	}
+}
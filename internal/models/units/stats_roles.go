@@ -2,7 +2,6 @@ package units
 
 import (
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -38,14 +37,15 @@ func StatsForRole(role string, cfg RolesLoader, opts ...Option) (Stats, error) {
 
 	roleKey, dmgType, ok := detectRoleKey(role, validRoles, damageTokens)
 	if !ok {
-		return Stats{}, fmt.Errorf("invalid role label %q: must contain a valid role token and optional valid damage type", role)
+		return Stats{}, fmt.Errorf("invalid role label %q: must contain a valid role token and optional valid damage type%s",
+			role, didYouMean(suggestRoleLabel(role, validRoles, damageTokens)))
 	}
 	_ = dmgType // still validated but not used for overrides yet
 
 	roleMap := findRoleOverrideMap(cfg.StatsPerRoles, roleKey)
 	if len(roleMap) == 0 {
 		if !cfg.Strict {
-			log.Printf("[roles] no overrides for role=%q (normalized=%q)", role, roleKey)
+			cfg.logger().Warn("no overrides for role", "role", role, "normalized", roleKey)
 		}
 		return base, nil
 	}
@@ -55,12 +55,25 @@ func StatsForRole(role string, cfg RolesLoader, opts ...Option) (Stats, error) {
 
 	if cfg.Strict && !report.empty() {
 		return Stats{}, fmt.Errorf("invalid role stats (%s): override issues: unknown_keys=%v, type_errors=%v",
-			role, report.UnknownKeys, report.TypeErrors,
+			role, report.unknownKeysWithHints(), report.TypeErrors,
 		)
 	}
 	if !cfg.Strict && (len(report.UnknownKeys) > 0 || len(report.TypeErrors) > 0) {
-		log.Printf("[roles] role=%q override warnings: unknown_keys=%v, type_errors=%v",
-			role, report.UnknownKeys, report.TypeErrors)
+		cfg.logger().Warn("role override warnings",
+			"role", role,
+			"normalized", roleKey,
+			"unknown_keys", report.UnknownKeys,
+			"suggestions", report.Suggestions,
+			"type_errors", report.TypeErrors,
+		)
+	}
+	// Deprecated aliases load fine in both modes; they only warn.
+	if len(report.Warnings) > 0 {
+		cfg.logger().Warn("role override deprecations",
+			"role", role,
+			"normalized", roleKey,
+			"warnings", report.Warnings,
+		)
 	}
 
 	if err := applied.Validate(); err != nil {
@@ -43,6 +43,7 @@ func sanitizeDefense(d DefenseStats) DefenseStats {
 		Armor:          nonNeg(d.Armor),
 		MR:             nonNeg(d.MR),
 		Durability:     nonNeg(d.Durability),
+		Tenacity:       clamp(d.Tenacity, 0, 1),
 		TargetPriority: clamp(d.TargetPriority, float64(minTP), float64(maxTP)),
 	}
 }
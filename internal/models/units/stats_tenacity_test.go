@@ -0,0 +1,14 @@
+package units
+
+import "testing"
+
+func TestSanitize_Tenacity_Clamp(t *testing.T) {
+	t.Parallel()
+
+	if s := build(t, WithTenacity(1.5)); s.Defense.Tenacity != 1 {
+		t.Fatalf("tenacity should clamp to 1, got %v", s.Defense.Tenacity)
+	}
+	if s := build(t, WithTenacity(-0.2)); s.Defense.Tenacity != 0 {
+		t.Fatalf("tenacity should clamp to 0, got %v", s.Defense.Tenacity)
+	}
+}
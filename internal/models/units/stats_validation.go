@@ -31,7 +31,7 @@ func (s Stats) Validate() error {
 		s.Offense.Range, s.Offense.BaseAD, s.Offense.AD, s.Offense.AP, s.Offense.AS,
 		s.Offense.CritChance, s.Offense.CritDamage, s.Offense.Omnivamp.OmnivampMax, s.Offense.Omnivamp.OmnivampMin, s.Offense.Omnivamp.CurrentOmnivamp, s.Offense.DamageAmp,
 		// Defense
-		s.Defense.HP, s.Defense.Armor, s.Defense.MR, s.Defense.Durability, s.Defense.TargetPriority,
+		s.Defense.HP, s.Defense.Armor, s.Defense.MR, s.Defense.Durability, s.Defense.Tenacity, s.Defense.TargetPriority,
 		// Resource (flat)
 		s.Resource.ManaMin, s.Resource.ManaMax, s.Resource.ManaStart, s.Resource.ManaRegen, s.Resource.ManaPerHit,
 		// Resource (mana from damage)
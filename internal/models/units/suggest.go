@@ -0,0 +1,17 @@
+package units
+
+import "github.com/0xm0-v1/simfight-tactics/internal/suggest"
+
+func closestMatch(s string, candidates []string) (string, bool) {
+	return suggest.Closest(s, candidates)
+}
+
+func didYouMean(suggestion string) string { return suggest.DidYouMean(suggestion) }
+
+func mapKeys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
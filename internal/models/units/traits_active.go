@@ -0,0 +1,86 @@
+package units
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// ActiveTrait is a trait's standing on a board.
+type ActiveTrait struct {
+	Name       string `json:"name"`
+	Count      int    `json:"count"`      // distinct champions carrying the trait, plus bonus grants
+	Tier       int    `json:"tier"`       // breakpoints reached (0 = inactive)
+	Breakpoint int    `json:"breakpoint"` // highest breakpoint reached (0 = inactive)
+	Next       int    `json:"next"`       // next breakpoint (0 = maxed)
+}
+
+// Active reports every catalog trait present on the board, highest tier
+// first. Copies of the same champion count once, as in game; traits missing
+// from the catalog are ignored.
+func (c TraitsLoader) Active(board []Unit) []ActiveTrait {
+	return c.ActiveWith(board, nil)
+}
+
+// ActiveWith is Active with bonus counts added per trait (augments and other
+// player-level grants), even for traits no champion carries.
+func (c TraitsLoader) ActiveWith(board []Unit, bonus map[string]int) []ActiveTrait {
+	champs := map[string]map[string]struct{}{}
+	for _, u := range board {
+		name := strings.ToLower(strings.TrimSpace(u.Name))
+		for _, tn := range u.Traits {
+			t, ok := c.Lookup(tn)
+			if !ok {
+				continue
+			}
+			if champs[t.Name] == nil {
+				champs[t.Name] = map[string]struct{}{}
+			}
+			champs[t.Name][name] = struct{}{}
+		}
+	}
+	extra := map[string]int{}
+	for tn, n := range bonus {
+		if t, ok := c.Lookup(tn); ok {
+			extra[t.Name] += n
+		}
+	}
+	out := make([]ActiveTrait, 0, len(champs)+len(extra))
+	for _, t := range c.Traits {
+		if n := len(champs[t.Name]) + extra[t.Name]; n > 0 {
+			out = append(out, t.standing(n))
+		}
+	}
+	slices.SortStableFunc(out, func(a, b ActiveTrait) int {
+		return cmp.Or(cmp.Compare(b.Tier, a.Tier), cmp.Compare(b.Count, a.Count))
+	})
+	return out
+}
+
+// Tier is the number of breakpoints reached with count champions.
+func (t Trait) Tier(count int) int {
+	tier := 0
+	for _, bp := range t.Breakpoints {
+		if count >= bp {
+			tier++
+		}
+	}
+	return tier
+}
+
+func (t Trait) standing(count int) ActiveTrait {
+	a := ActiveTrait{Name: t.Name, Count: count, Tier: t.Tier(count)}
+	if a.Tier > 0 {
+		a.Breakpoint = t.Breakpoints[a.Tier-1]
+	}
+	if a.Tier < len(t.Breakpoints) {
+		a.Next = t.Breakpoints[a.Tier]
+	}
+	return a
+}
+
+// Unique reports whether the trait activates with a single champion only
+// (breakpoints [1]), like a champion's signature trait.
+func (t Trait) Unique() bool {
+	return len(t.Breakpoints) == 1 && t.Breakpoints[0] == 1
+}
@@ -0,0 +1,43 @@
+package units
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTraitsLoader_Active(t *testing.T) {
+	t.Parallel()
+	c := testTraits()
+	board := []Unit{
+		{Name: "Ezreal", Traits: []string{"Battle Academia"}},
+		{Name: "Garen", Traits: []string{"battle academia", "Bastion"}},
+		{Name: "Garen", Traits: []string{"Battle Academia", "Bastion"}}, // duplicate champion counts once
+		{Name: "Rell", Traits: []string{"Bastion", "Star Guardian"}},    // not in the catalog
+		{Name: "Katarina", Traits: []string{"Battle Academia"}},
+	}
+	got := c.Active(board)
+	want := []ActiveTrait{
+		{Name: "Battle Academia", Count: 3, Tier: 1, Breakpoint: 3, Next: 5},
+		{Name: "Bastion", Count: 2, Tier: 1, Breakpoint: 2, Next: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Active = %+v, want %+v", got, want)
+	}
+	if tier := c.Traits[1].Tier(7); tier != 3 {
+		t.Fatalf("Bastion tier at 7 = %d, want 3 (maxed)", tier)
+	}
+}
+
+func TestTraitsLoader_ActiveWithBonus(t *testing.T) {
+	t.Parallel()
+	c := testTraits()
+	board := []Unit{{Name: "Garen", Traits: []string{"Bastion"}}}
+	got := c.ActiveWith(board, map[string]int{"bastion": 1, "Battle Academia": 1, "Star Guardian": 1})
+	want := []ActiveTrait{
+		{Name: "Bastion", Count: 2, Tier: 1, Breakpoint: 2, Next: 4},
+		{Name: "Battle Academia", Count: 1, Tier: 0, Next: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ActiveWith = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package units
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	json "encoding/json/v2"
+)
+
+// Trait is a synergy (origin or class) with its activation breakpoints in ascending order.
+type Trait struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"` // "origin" | "class"
+	Breakpoints []int  `json:"breakpoints"`
+}
+
+type TraitsLoader struct {
+	Traits []Trait `json:"traits"`
+}
+
+func LoadTraits(path string) (TraitsLoader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return TraitsLoader{}, fmt.Errorf("read traits config: %w", err)
+	}
+	var cfg TraitsLoader
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return TraitsLoader{}, fmt.Errorf("parse traits config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Names returns the configured trait names in config order.
+func (c TraitsLoader) Names() []string {
+	out := make([]string, 0, len(c.Traits))
+	for _, t := range c.Traits {
+		out = append(out, t.Name)
+	}
+	return out
+}
+
+// Lookup finds a trait by name (case-insensitive, surrounding spaces ignored).
+func (c TraitsLoader) Lookup(name string) (Trait, bool) {
+	n := strings.TrimSpace(name)
+	for _, t := range c.Traits {
+		if strings.EqualFold(t.Name, n) {
+			return t, true
+		}
+	}
+	return Trait{}, false
+}
@@ -0,0 +1,55 @@
+package units
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidateTraitsConfig checks names are set and unique and breakpoints strictly ascend from >= 1.
+func ValidateTraitsConfig(cfg TraitsLoader) error {
+	var issues []string
+	seen := make(map[string]struct{}, len(cfg.Traits))
+	for i, t := range cfg.Traits {
+		key := strings.ToLower(strings.TrimSpace(t.Name))
+		if key == "" {
+			issues = append(issues, fmt.Sprintf("traits[%d]: empty name", i))
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			issues = append(issues, fmt.Sprintf("%s: duplicate trait", t.Name))
+		}
+		seen[key] = struct{}{}
+
+		if len(t.Breakpoints) == 0 {
+			issues = append(issues, fmt.Sprintf("%s: no breakpoints", t.Name))
+		}
+		for j, bp := range t.Breakpoints {
+			if bp < 1 || (j > 0 && bp <= t.Breakpoints[j-1]) {
+				issues = append(issues, fmt.Sprintf("%s: breakpoints must be >= 1 and strictly ascending, got %v", t.Name, t.Breakpoints))
+				break
+			}
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("traits config validation issues: %v", issues)
+}
+
+// ValidateTraits rejects trait names that are not in the catalog, with typo hints.
+func (c TraitsLoader) ValidateTraits(traits []string) error {
+	var unknown []string
+	for _, name := range traits {
+		if _, ok := c.Lookup(name); ok {
+			continue
+		}
+		hint, _ := closestMatch(name, c.Names())
+		unknown = append(unknown, fmt.Sprintf("%q%s", name, didYouMean(hint)))
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unknown traits: %s", strings.Join(unknown, ", "))
+}
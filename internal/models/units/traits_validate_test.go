@@ -0,0 +1,77 @@
+package units
+
+import (
+	"strings"
+	"testing"
+)
+
+func testTraits() TraitsLoader {
+	return TraitsLoader{Traits: []Trait{
+		{Name: "Battle Academia", Kind: "origin", Breakpoints: []int{3, 5, 7}},
+		{Name: "Bastion", Kind: "class", Breakpoints: []int{2, 4, 6}},
+	}}
+}
+
+func TestTraitsLoader_ValidateTraits_Suggestions(t *testing.T) {
+	t.Parallel()
+	c := testTraits()
+	if err := c.ValidateTraits([]string{"battle academia", " Bastion "}); err != nil {
+		t.Fatalf("case/space-insensitive names should pass: %v", err)
+	}
+	err := c.ValidateTraits([]string{"Battle Acadmy", "Warlord"})
+	if err == nil {
+		t.Fatalf("expected unknown trait error")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, `"Battle Acadmy" (did you mean "Battle Academia"?)`) || !strings.Contains(msg, `"Warlord"`) {
+		t.Fatalf("unexpected message: %v", msg)
+	}
+}
+
+func TestValidateTraitsConfig(t *testing.T) {
+	t.Parallel()
+	if err := ValidateTraitsConfig(testTraits()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	bad := TraitsLoader{Traits: []Trait{
+		{Name: "A", Breakpoints: []int{2, 2}},
+		{Name: "a", Breakpoints: []int{1}},
+		{Name: "", Breakpoints: []int{1}},
+		{Name: "B"},
+	}}
+	err := ValidateTraitsConfig(bad)
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	for _, want := range []string{"strictly ascending", "duplicate", "empty name", "no breakpoints"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("missing %q in %v", want, err)
+		}
+	}
+}
+
+func TestUnitFactory_WithTraits_RejectsUnknown(t *testing.T) {
+	t.Parallel()
+	f := NewUnitFactory(RolesLoader{RoleTypes: []string{"Tank"}, StatsPerRoles: map[string]any{"tank": map[string]any{}}}, WithTraits(testTraits()))
+	if _, err := f.Build("Garen", 1, []string{"Battle Academia", "Bastion"}, []string{"Tank"}, WithRange(1)); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := f.Build("Garen", 1, []string{"Bastoin"}, []string{"Tank"}, WithRange(1))
+	if err == nil || !strings.Contains(err.Error(), `did you mean "Bastion"?`) {
+		t.Fatalf("expected trait hint, got %v", err)
+	}
+}
+
+func TestLoadTraits_Set15Config(t *testing.T) {
+	t.Parallel()
+	cfg, err := LoadTraits("../../config/set15/traits.json")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if err := ValidateTraitsConfig(cfg); err != nil {
+		t.Fatalf("set15 traits invalid: %v", err)
+	}
+	if _, ok := cfg.Lookup("Star Guardian"); !ok {
+		t.Fatalf("expected Star Guardian in set15 traits")
+	}
+}
@@ -2,22 +2,94 @@ package units
 
 import (
 	"fmt"
+	"log/slog"
 )
 
-// BuildUnit create a unit by choosing a primary role to override Stats.
-func BuildUnit(name string, cost int, traits []string, roles []string, cfg RolesLoader, statOpts ...Option) (Unit, error) {
+// UnitFactory builds units from a roles config with injectable dependencies.
+// The zero value is not usable; create one with NewUnitFactory.
+type UnitFactory struct {
+	cfg    RolesLoader
+	ids    IDGenerator
+	traits *TraitsLoader // nil → trait names are not checked
+	game   GameContext   // zero → no stage scaling
+	stages StagesLoader
+}
+
+// FactoryOption configures a UnitFactory.
+type FactoryOption func(*UnitFactory)
+
+// WithIDGenerator replaces the default random ID generator (nil keeps the default).
+func WithIDGenerator(g IDGenerator) FactoryOption {
+	return func(f *UnitFactory) {
+		if g != nil {
+			f.ids = g
+		}
+	}
+}
+
+// WithLogger routes role warnings emitted while building to l
+// (e.g. a logger carrying request attributes). Nil keeps the roles config logger.
+func WithLogger(l *slog.Logger) FactoryOption {
+	return func(f *UnitFactory) {
+		if l != nil {
+			f.cfg.Logger = l
+		}
+	}
+}
+
+// WithTraits makes the factory reject trait names missing from the catalog.
+func WithTraits(c TraitsLoader) FactoryOption {
+	return func(f *UnitFactory) {
+		f.traits = &c
+	}
+}
+
+// WithGameContext builds units for the given stage: stats listed in the
+// stages table are interpolated at ctx after role overrides and options.
+func WithGameContext(ctx GameContext, stages StagesLoader) FactoryOption {
+	return func(f *UnitFactory) {
+		f.game = ctx
+		f.stages = stages
+	}
+}
+
+// NewUnitFactory returns a factory using cfg for role overrides and random IDs by default.
+func NewUnitFactory(cfg RolesLoader, opts ...FactoryOption) UnitFactory {
+	f := UnitFactory{cfg: cfg, ids: RandomIDs{}}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+// Build creates a unit in board slot 0. See BuildAt.
+func (f UnitFactory) Build(name string, cost int, traits []string, roles []string, statOpts ...Option) (Unit, error) {
+	return f.BuildAt(0, name, cost, traits, roles, statOpts...)
+}
+
+// BuildAt creates a unit by choosing a primary role to override Stats.
+// slot identifies the unit's board position and feeds deterministic ID generators.
+func (f UnitFactory) BuildAt(slot int, name string, cost int, traits []string, roles []string, statOpts ...Option) (Unit, error) {
 	if len(roles) == 0 {
 		return Unit{}, fmt.Errorf("no role provided for unit %q", name)
 	}
+	if f.traits != nil {
+		if err := f.traits.ValidateTraits(traits); err != nil {
+			return Unit{}, fmt.Errorf("unit %q: %w", name, err)
+		}
+	}
 	primary := roles[0]
 
-	stats, err := StatsForRole(primary, cfg, statOpts...)
+	stats, err := StatsForRole(primary, f.cfg, statOpts...)
 	if err != nil {
 		return Unit{}, err
 	}
+	if stats, err = f.stages.Apply(stats, f.game); err != nil {
+		return Unit{}, fmt.Errorf("unit %q at stage %s: %w", name, f.game, err)
+	}
 
 	u := Unit{
-		ID:     NewUUID(),
+		ID:     f.ids.UnitID(name, slot),
 		Name:   name,
 		Cost:   cost,
 		Traits: traits,
@@ -26,3 +98,9 @@ func BuildUnit(name string, cost int, traits []string, roles []string, cfg Roles
 	}
 	return u, nil
 }
+
+// BuildUnit create a unit by choosing a primary role to override Stats.
+// IDs are random; use a UnitFactory with DeterministicIDs for reproducible runs.
+func BuildUnit(name string, cost int, traits []string, roles []string, cfg RolesLoader, statOpts ...Option) (Unit, error) {
+	return NewUnitFactory(cfg).Build(name, cost, traits, roles, statOpts...)
+}
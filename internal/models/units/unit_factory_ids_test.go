@@ -0,0 +1,69 @@
+package units
+
+import "testing"
+
+func idTestCfg() RolesLoader {
+	return RolesLoader{
+		RoleTypes:     []string{"Tank"},
+		DamageTypes:   []string{"Attack"},
+		StatsPerRoles: map[string]any{},
+	}
+}
+
+func TestUnitFactory_DeterministicIDs_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	build := func(slot int, seed int64) Unit {
+		t.Helper()
+		f := NewUnitFactory(idTestCfg(), WithIDGenerator(NewDeterministicIDs("golden", seed)))
+		u, err := f.BuildAt(slot, "Garen", 1, nil, []string{"Attack Tank"}, WithRange(1))
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		return u
+	}
+
+	a, b := build(3, 42), build(3, 42)
+	if a.ID != b.ID {
+		t.Fatalf("same run/name/slot/seed must give same ID: %v vs %v", a.ID, b.ID)
+	}
+	// Pinned value: golden files and result caches depend on it never drifting.
+	if got, want := a.ID.String(), "a1e20814-5af6-566e-8576-362f9441f3eb"; got != want {
+		t.Fatalf("golden ID drifted: got %s want %s", got, want)
+	}
+	if a.ID.Version() != 5 {
+		t.Fatalf("expected UUIDv5, got v%d", a.ID.Version())
+	}
+	if build(4, 42).ID == a.ID {
+		t.Fatalf("different slot must give different ID")
+	}
+	if build(3, 43).ID == a.ID {
+		t.Fatalf("different seed must give different ID")
+	}
+}
+
+func TestUnitFactory_DeterministicIDs_NamespaceScoped(t *testing.T) {
+	t.Parallel()
+
+	a := NewDeterministicIDs("run-a", 1).UnitID("Garen", 0)
+	b := NewDeterministicIDs("run-b", 1).UnitID("Garen", 0)
+	if a == b {
+		t.Fatalf("different run namespaces must give different IDs")
+	}
+}
+
+func TestBuildUnit_DefaultIDs_AreRandom(t *testing.T) {
+	t.Parallel()
+
+	a, err := BuildUnit("Foo", 1, nil, []string{"Attack Tank"}, idTestCfg(), WithRange(1))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	b, err := BuildUnit("Foo", 1, nil, []string{"Attack Tank"}, idTestCfg(), WithRange(1))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if a.ID == b.ID {
+		t.Fatalf("default factory should keep random IDs")
+	}
+}
@@ -1,5 +1,46 @@
 package units
 
-import "github.com/google/uuid"
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+)
 
 func NewUUID() uuid.UUID { return uuid.New() }
+
+// IDGenerator produces unit IDs for the factory.
+// Deterministic implementations must return the same ID for the same (name, slot).
+type IDGenerator interface {
+	UnitID(name string, slot int) uuid.UUID
+}
+
+// RandomIDs is the default generator: random UUIDv4, different on every run.
+type RandomIDs struct{}
+
+func (RandomIDs) UnitID(string, int) uuid.UUID { return NewUUID() }
+
+// idRootNamespace scopes every run namespace to this project.
+var idRootNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/0xm0-v1/simfight-tactics/units"))
+
+// RunNamespace derives a stable UUIDv5 namespace from a run name (scenario, cache key, ...).
+func RunNamespace(run string) uuid.UUID {
+	return uuid.NewSHA1(idRootNamespace, []byte(run))
+}
+
+// DeterministicIDs derives UUIDv5 IDs from a run namespace, the unit name,
+// its board slot and the run seed. Identical runs get byte-identical IDs.
+type DeterministicIDs struct {
+	Namespace uuid.UUID
+	Seed      int64
+}
+
+// NewDeterministicIDs returns a generator scoped to the named run and seed.
+func NewDeterministicIDs(run string, seed int64) DeterministicIDs {
+	return DeterministicIDs{Namespace: RunNamespace(run), Seed: seed}
+}
+
+func (g DeterministicIDs) UnitID(name string, slot int) uuid.UUID {
+	// name|slot|seed — separators keep ("a1", 2) and ("a", 12) distinct.
+	key := name + "|" + strconv.Itoa(slot) + "|" + strconv.FormatInt(g.Seed, 10)
+	return uuid.NewSHA1(g.Namespace, []byte(key))
+}
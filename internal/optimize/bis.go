@@ -0,0 +1,180 @@
+package optimize
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// BISConfig tunes BestInSlot. Zero fields take the defaults.
+type BISConfig struct {
+	Objective Objective // default dps
+	Slots     int       // items per build (default items.MaxItems)
+	Pool      []string  // available components, repeats allowed; empty → any build
+	Keep      int       // builds simulated after analytical pruning (default 16)
+	Top       int       // builds returned (default 5)
+	sim.MonteCarloConfig
+}
+
+const (
+	defaultKeep = 16
+	defaultTop  = 5
+)
+
+// Build is one ranked item set.
+type Build struct {
+	Rank     int      `json:"rank"`
+	Items    []string `json:"items"`
+	Estimate float64  `json:"estimate"` // analytical pruning score (DPS, or effective HP for survival)
+	Score    Score    `json:"score"`
+}
+
+// BISResult ranks the best builds for one unit.
+type BISResult struct {
+	Unit       string    `json:"unit"`
+	Objective  Objective `json:"objective"`
+	Candidates int       `json:"candidates"` // legal builds considered
+	Simulated  int       `json:"simulated"`  // builds that survived pruning
+	Builds     []Build   `json:"builds"`     // best first
+}
+
+// BestInSlot searches every legal combination of completed items for the
+// named unit of s. Candidates are ranked by the analytical estimate
+// (ExpectedDPS against the enemy board, or effective HP for the survival
+// objective), the best cfg.Keep are simulated with the same seeds and the
+// best cfg.Top are returned with 95% confidence intervals. Items already on
+// the unit are replaced.
+func BestInSlot(s sim.Scenario, unit string, catalog items.ItemsLoader, cfg BISConfig) (BISResult, error) {
+	cfg, err := cfg.withDefaults()
+	if err != nil {
+		return BISResult{}, err
+	}
+	idx, ok := s.Locate(unit)
+	if !ok {
+		return BISResult{}, fmt.Errorf("scenario %q: no unit named %q", s.Name, unit)
+	}
+	pool, err := componentPool(catalog, cfg.Pool)
+	if err != nil {
+		return BISResult{}, err
+	}
+
+	base, enemies := s.PlacementAt(idx)
+	defense := sim.MeanDefense(enemies)
+	type candidate struct {
+		held     []items.Item
+		estimate float64
+	}
+	var cands []candidate
+	for _, held := range combinations(catalog.OfKind(items.Completed), cfg.Slots) {
+		if pool != nil && !fits(held, pool) {
+			continue
+		}
+		u, err := items.Equip(base.Unit, held...)
+		if err != nil {
+			continue // unique item twice
+		}
+		cands = append(cands, candidate{held, estimate(cfg.Objective, u, base, defense, s.Config.Timing)})
+	}
+	if len(cands) == 0 {
+		return BISResult{}, fmt.Errorf("no legal %d-item build for %s from pool %v", cfg.Slots, unit, cfg.Pool)
+	}
+	slices.SortStableFunc(cands, func(a, b candidate) int { return cmp.Compare(b.estimate, a.estimate) })
+
+	out := BISResult{Unit: base.Unit.Name, Objective: cfg.Objective, Candidates: len(cands)}
+	for _, c := range cands[:min(cfg.Keep, len(cands))] {
+		p := base
+		p.Items = c.held
+		score, _, err := simulate(s.WithPlacement(idx, p), idx, cfg.Objective, cfg.MonteCarloConfig)
+		if err != nil {
+			return BISResult{}, fmt.Errorf("%v: %w", items.Names(c.held), err)
+		}
+		out.Builds = append(out.Builds, Build{Items: items.Names(c.held), Estimate: c.estimate, Score: score})
+	}
+	out.Simulated = len(out.Builds)
+	slices.SortStableFunc(out.Builds, func(a, b Build) int { return cfg.Objective.compare(a.Score.Mean, b.Score.Mean) })
+	out.Builds = out.Builds[:min(cfg.Top, len(out.Builds))]
+	for i := range out.Builds {
+		out.Builds[i].Rank = i + 1
+	}
+	return out, nil
+}
+
+func (c BISConfig) withDefaults() (BISConfig, error) {
+	o, err := ParseObjective(string(c.Objective))
+	if err != nil {
+		return BISConfig{}, err
+	}
+	c.Objective = o
+	if c.Slots <= 0 {
+		c.Slots = items.MaxItems
+	}
+	if c.Slots > items.MaxItems {
+		return BISConfig{}, fmt.Errorf("slots: at most %d, got %d", items.MaxItems, c.Slots)
+	}
+	if c.Keep <= 0 {
+		c.Keep = defaultKeep
+	}
+	if c.Top <= 0 {
+		c.Top = defaultTop
+	}
+	return c, nil
+}
+
+// estimate is the analytical pruning score of u (holding its candidate
+// items) against defense; higher is better for every objective.
+func estimate(o Objective, u units.Unit, p sim.Placement, defense units.DefenseStats, timing sim.AttackTiming) float64 {
+	if o == ObjectiveSurvival {
+		d := u.Stats.Defense
+		return d.HP * (1 + (d.Armor+d.MR)/200) / max(1-d.Durability, 0.01)
+	}
+	return sim.ExpectedDPS(u, p.Ability, p.Star, defense, timing).Total
+}
+
+// combinations lists every multiset of n items from list, in list order.
+func combinations(list []items.Item, n int) [][]items.Item {
+	var out [][]items.Item
+	var rec func(start int, cur []items.Item)
+	rec = func(start int, cur []items.Item) {
+		if len(cur) == n {
+			out = append(out, slices.Clone(cur))
+			return
+		}
+		for i := start; i < len(list); i++ {
+			rec(i, append(cur, list[i]))
+		}
+	}
+	rec(0, nil)
+	return out
+}
+
+// componentPool counts the named components (nil when names is empty).
+func componentPool(catalog items.ItemsLoader, names []string) (map[string]int, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	pool := map[string]int{}
+	for _, n := range names {
+		it, ok := catalog.Lookup(n)
+		if !ok || it.Kind != items.Component {
+			return nil, fmt.Errorf("pool: %q is not a component", n)
+		}
+		pool[strings.ToLower(it.Name)]++
+	}
+	return pool, nil
+}
+
+// fits reports whether held can be built from the pool.
+func fits(held []items.Item, pool map[string]int) bool {
+	need := map[string]int{}
+	for _, c := range items.Components(held) {
+		if need[strings.ToLower(c)]++; need[strings.ToLower(c)] > pool[strings.ToLower(c)] {
+			return false
+		}
+	}
+	return true
+}
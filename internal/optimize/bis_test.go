@@ -0,0 +1,108 @@
+package optimize
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+var bisPool = []string{
+	"B.F. Sword", "B.F. Sword", "Recurve Bow", "Recurve Bow",
+	"Chain Vest", "Chain Vest", "Giant's Belt", "Giant's Belt",
+}
+
+func TestBestInSlot_RanksOffensiveBuildsForDPS(t *testing.T) {
+	t.Parallel()
+	res, err := BestInSlot(duel(t), "carry", loadItems(t), BISConfig{
+		Pool: bisPool, Keep: 6, Top: 3, MonteCarloConfig: sim.MonteCarloConfig{Runs: 40},
+	})
+	if err != nil {
+		t.Fatalf("bis: %v", err)
+	}
+	if res.Candidates <= res.Simulated || res.Simulated != 6 || len(res.Builds) != 3 {
+		t.Fatalf("pruning: %d candidates, %d simulated, %d returned", res.Candidates, res.Simulated, len(res.Builds))
+	}
+	for i, b := range res.Builds {
+		if b.Rank != i+1 || b.Score.Runs != 40 || b.Score.Low > b.Score.Mean || b.Score.High < b.Score.Mean {
+			t.Fatalf("build %d: %+v", i, b)
+		}
+		if i > 0 && b.Score.Mean > res.Builds[i-1].Score.Mean {
+			t.Fatalf("builds not sorted by DPS: %+v", res.Builds)
+		}
+	}
+	// The best build spends every offensive component of the pool.
+	offense := 0
+	for _, c := range items.Components(lookup(t, res.Builds[0].Items)) {
+		if c == "B.F. Sword" || c == "Recurve Bow" {
+			offense++
+		}
+	}
+	if offense != 4 {
+		t.Fatalf("best DPS build %v uses %d of 4 offensive components", res.Builds[0].Items, offense)
+	}
+}
+
+func lookup(t *testing.T, names []string) []items.Item {
+	t.Helper()
+	catalog := loadItems(t)
+	out := make([]items.Item, len(names))
+	for i, n := range names {
+		it, ok := catalog.Lookup(n)
+		if !ok {
+			t.Fatalf("unknown item %q", n)
+		}
+		out[i] = it
+	}
+	return out
+}
+
+func TestBestInSlot_SurvivalPrefersDefense(t *testing.T) {
+	t.Parallel()
+	res, err := BestInSlot(duel(t), "carry", loadItems(t), BISConfig{
+		Objective: ObjectiveSurvival, Pool: bisPool, Keep: 4, Top: 1, MonteCarloConfig: sim.MonteCarloConfig{Runs: 20},
+	})
+	if err != nil {
+		t.Fatalf("bis: %v", err)
+	}
+	if best := res.Builds[0].Items; slices.Contains(best, "Deathblade") || slices.Contains(best, "Red Buff") {
+		t.Fatalf("offensive item in the best survival build: %v", best)
+	}
+}
+
+func TestBestInSlot_DeterministicAcrossWorkers(t *testing.T) {
+	t.Parallel()
+	run := func(workers int) BISResult {
+		res, err := BestInSlot(duel(t), "carry", loadItems(t), BISConfig{
+			Pool: bisPool, Keep: 3, MonteCarloConfig: sim.MonteCarloConfig{Runs: 10, Workers: workers},
+		})
+		if err != nil {
+			t.Fatalf("bis: %v", err)
+		}
+		return res
+	}
+	if a, b := run(1), run(4); !reflect.DeepEqual(a, b) {
+		t.Fatalf("results depend on worker count:\n%+v\n%+v", a, b)
+	}
+}
+
+func TestBestInSlot_Errors(t *testing.T) {
+	t.Parallel()
+	catalog := loadItems(t)
+	for name, tc := range map[string]struct {
+		unit string
+		cfg  BISConfig
+	}{
+		"unknown unit":      {"nobody", BISConfig{}},
+		"not a component":   {"carry", BISConfig{Pool: []string{"Deathblade"}}},
+		"pool too small":    {"carry", BISConfig{Pool: []string{"B.F. Sword", "B.F. Sword"}}},
+		"unknown objective": {"carry", BISConfig{Objective: "fun"}},
+		"too many slots":    {"carry", BISConfig{Slots: 4}},
+	} {
+		if _, err := BestInSlot(duel(t), tc.unit, catalog, tc.cfg); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
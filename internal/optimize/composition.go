@@ -0,0 +1,460 @@
+package optimize
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// CompConfig tunes Compositions. Zero fields take the defaults.
+type CompConfig struct {
+	Level       int      // team size (required)
+	Must        []string // champions every composition includes
+	CostCap     int      // max summed champion cost (0 → none)
+	Top         int      // compositions returned (default 5)
+	CountUnique bool     // count single-champion traits (breakpoints [1]) in the score
+	MaxNodes    int      // search nodes before giving up exhaustiveness (default 5,000,000)
+
+	// Emblems are traits granted by emblems, one entry per emblem. Each
+	// counts for the trait when a member without it has a free item slot.
+	Emblems []string
+
+	// Reference, when set, is an enemy board: the best Keep compositions by
+	// traits are placed with sim.Formation and re-ranked by the team
+	// Objective over seeded runs against it.
+	Reference sim.Board
+	Objective Objective
+	Keep      int // default 16
+	Sim       sim.Config
+	sim.MonteCarloConfig
+}
+
+const defaultMaxNodes = 5_000_000
+
+// Comp is one ranked composition.
+type Comp struct {
+	Rank   int                 `json:"rank"`
+	Units  []string            `json:"units"` // roster order
+	Cost   int                 `json:"cost"`
+	Tiers  int                 `json:"tiers"`  // trait breakpoints reached (the ranking score)
+	Active int                 `json:"active"` // traits with at least one breakpoint
+	Traits []units.ActiveTrait `json:"traits"`
+	// Emblems places the emblems that count: each on a member without the
+	// trait, at most items.MaxItems items per member counting those held.
+	Emblems []Emblem `json:"emblems,omitempty"`
+	Score   *Score   `json:"score,omitempty"` // with a Reference board
+}
+
+// Emblem is one emblem of CompConfig.Emblems given to a composition member.
+type Emblem struct {
+	Trait string `json:"trait"`
+	Unit  string `json:"unit"`
+}
+
+// CompResult ranks compositions.
+type CompResult struct {
+	Level      int    `json:"level"`
+	Nodes      int    `json:"nodes"`      // search nodes visited
+	Exhaustive bool   `json:"exhaustive"` // false when MaxNodes stopped the search
+	Best       []Comp `json:"best"`
+}
+
+// Compositions searches teams of cfg.Level champions from the roster
+// (positions are ignored) that include cfg.Must and fit cfg.CostCap, ranked
+// by trait breakpoints reached, then active traits, then lower cost.
+//
+// The search is a depth-first branch and bound over the roster: a branch is
+// cut when an optimistic bound on its completions (see compSearch.bound)
+// cannot beat the current Top-th composition, or when its cheapest
+// completion breaks the cost cap.
+func Compositions(roster []sim.Placement, traits units.TraitsLoader, cfg CompConfig) (CompResult, error) {
+	cfg, err := cfg.withDefaults()
+	if err != nil {
+		return CompResult{}, err
+	}
+	s, err := newCompSearch(roster, traits, cfg)
+	if err != nil {
+		return CompResult{}, err
+	}
+	s.run()
+
+	out := CompResult{Level: cfg.Level, Nodes: s.nodes, Exhaustive: s.nodes < cfg.MaxNodes}
+	for _, c := range s.best {
+		board := make([]units.Unit, 0, len(c.members))
+		comp := Comp{Cost: c.cost, Tiers: c.tiers, Active: c.active}
+		for _, i := range c.members {
+			board = append(board, roster[i].Unit)
+			comp.Units = append(comp.Units, roster[i].Unit.Name)
+		}
+		bonus := map[string]int{}
+		for _, h := range s.hold(c.members) {
+			comp.Emblems = append(comp.Emblems, Emblem{Trait: s.traits[h.trait].Name, Unit: s.names[h.member]})
+			bonus[s.traits[h.trait].Name]++
+		}
+		comp.Traits = traits.ActiveWith(board, bonus)
+		out.Best = append(out.Best, comp)
+	}
+
+	if len(cfg.Reference) > 0 {
+		for i, c := range s.best {
+			sc := sim.Scenario{Name: strings.Join(out.Best[i].Units, ","), Blue: sim.Formation(sim.TeamBlue, s.team(roster, c.members)), Red: cfg.Reference, Config: cfg.Sim}
+			score, _, err := simulateTeam(sc, sim.TeamBlue, cfg.Objective, cfg.MonteCarloConfig)
+			if err != nil {
+				return CompResult{}, err
+			}
+			out.Best[i].Score = &score
+		}
+		slices.SortStableFunc(out.Best, func(a, b Comp) int { return cfg.Objective.compare(a.Score.Mean, b.Score.Mean) })
+	}
+	out.Best = out.Best[:min(cfg.Top, len(out.Best))]
+	for i := range out.Best {
+		out.Best[i].Rank = i + 1
+	}
+	return out, nil
+}
+
+func (c CompConfig) withDefaults() (CompConfig, error) {
+	if c.Level < 1 {
+		return CompConfig{}, fmt.Errorf("level: must be >= 1, got %d", c.Level)
+	}
+	o, err := ParseObjective(string(c.Objective))
+	if err != nil {
+		return CompConfig{}, err
+	}
+	c.Objective = o
+	if c.Top <= 0 {
+		c.Top = defaultTop
+	}
+	if c.Keep <= 0 {
+		c.Keep = defaultKeep
+	}
+	if c.MaxNodes <= 0 {
+		c.MaxNodes = defaultMaxNodes
+	}
+	return c, nil
+}
+
+// compSearch is the branch-and-bound state. Traits are indexed by their
+// catalog position; champions by roster position.
+type compSearch struct {
+	cfg    CompConfig
+	traits []units.Trait
+	counts []bool  // traits that count towards the score
+	emblem []int   // trait index → emblems granting it
+	has    [][]int // roster index → trait indices
+	cost   []int   // roster index → cost
+	slots  []int   // roster index → free item slots
+	names  []string
+	order  []int   // optional champions, search order
+	avail  [][]int // avail[k][t]: champions with trait t in order[k:]
+	cheap  [][]int // cheap[k]: costs of order[k:], ascending
+	width  []int   // width[k]: most counted traits on one champion of order[k:]
+	must   []int   // roster indices always included
+	keep   int     // compositions kept while searching
+	best   []compCandidate
+	nodes  int
+}
+
+type compCandidate struct {
+	members []int // roster indices, ascending
+	cost    int
+	tiers   int
+	active  int
+}
+
+func newCompSearch(roster []sim.Placement, catalog units.TraitsLoader, cfg CompConfig) (*compSearch, error) {
+	s := &compSearch{cfg: cfg, traits: catalog.Traits, keep: cfg.Top}
+	if len(cfg.Reference) > 0 {
+		s.keep = max(cfg.Top, cfg.Keep)
+	}
+	for _, t := range catalog.Traits {
+		s.counts = append(s.counts, cfg.CountUnique || !t.Unique())
+	}
+
+	var issues []string
+	s.emblem = make([]int, len(catalog.Traits))
+	for _, tn := range cfg.Emblems {
+		ti := slices.IndexFunc(catalog.Traits, func(t units.Trait) bool { return strings.EqualFold(t.Name, strings.TrimSpace(tn)) })
+		switch {
+		case ti < 0:
+			issues = append(issues, fmt.Sprintf("emblems: %v", catalog.ValidateTraits([]string{tn})))
+		case catalog.Traits[ti].Unique():
+			issues = append(issues, fmt.Sprintf("emblems: %s is a unique trait", catalog.Traits[ti].Name))
+		default:
+			s.emblem[ti]++
+		}
+	}
+	seen := map[string]int{}
+	for i, p := range roster {
+		key := strings.ToLower(strings.TrimSpace(p.Unit.Name))
+		if _, dup := seen[key]; dup {
+			issues = append(issues, fmt.Sprintf("%s: duplicate champion", p.Unit.Name))
+		}
+		seen[key] = i
+		if err := catalog.ValidateTraits(p.Unit.Traits); err != nil {
+			issues = append(issues, fmt.Sprintf("%s: %v", p.Unit.Name, err))
+		}
+		var idx []int
+		for _, tn := range p.Unit.Traits {
+			for ti, t := range catalog.Traits {
+				if strings.EqualFold(t.Name, strings.TrimSpace(tn)) && !slices.Contains(idx, ti) {
+					idx = append(idx, ti)
+				}
+			}
+		}
+		s.has = append(s.has, idx)
+		s.cost = append(s.cost, p.Unit.Cost)
+		s.slots = append(s.slots, max(items.MaxItems-len(p.Items), 0))
+		s.names = append(s.names, p.Unit.Name)
+	}
+	for _, name := range cfg.Must {
+		i, ok := seen[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("must: %q is not in the roster", name))
+			continue
+		}
+		if !slices.Contains(s.must, i) {
+			s.must = append(s.must, i)
+		}
+	}
+	if len(s.must) > cfg.Level {
+		issues = append(issues, fmt.Sprintf("must: %d champions for level %d", len(s.must), cfg.Level))
+	}
+	if len(roster) < cfg.Level {
+		issues = append(issues, fmt.Sprintf("roster: %d champions for level %d", len(roster), cfg.Level))
+	}
+	if len(issues) > 0 {
+		slices.Sort(issues)
+		return nil, fmt.Errorf("composition search issues: %v", issues)
+	}
+
+	// Champions sharing the most counted traits with the roster go first, so
+	// good compositions are found early and the bound bites sooner.
+	shared := make([]int, len(roster))
+	for i, ts := range s.has {
+		for _, t := range ts {
+			if s.counts[t] {
+				shared[i] += len(s.holders(t)) - 1
+			}
+		}
+	}
+	for i := range roster {
+		if !slices.Contains(s.must, i) {
+			s.order = append(s.order, i)
+		}
+	}
+	slices.SortStableFunc(s.order, func(a, b int) int {
+		return cmp.Or(cmp.Compare(shared[b], shared[a]), cmp.Compare(s.cost[a], s.cost[b]))
+	})
+	s.avail = make([][]int, len(s.order)+1)
+	s.cheap = make([][]int, len(s.order)+1)
+	s.width = make([]int, len(s.order)+1)
+	s.avail[len(s.order)] = make([]int, len(s.traits))
+	for k := len(s.order) - 1; k >= 0; k-- {
+		s.avail[k] = slices.Clone(s.avail[k+1])
+		for _, t := range s.has[s.order[k]] {
+			s.avail[k][t]++
+		}
+		s.cheap[k] = slices.Sorted(slices.Values(append(slices.Clone(s.cheap[k+1]), s.cost[s.order[k]])))
+		w := 0
+		for _, t := range s.has[s.order[k]] {
+			if s.counts[t] {
+				w++
+			}
+		}
+		s.width[k] = max(s.width[k+1], w)
+	}
+	return s, nil
+}
+
+// emblemHold is one emblem of trait held by roster champion member.
+type emblemHold struct{ trait, member int }
+
+// hold gives each emblem that counts to a member without its trait and with
+// a free item slot, trait by trait, members with the most free slots first
+// (ties in roster order).
+func (s *compSearch) hold(members []int) []emblemHold {
+	var out []emblemHold
+	held := map[int]int{}
+	for t, n := range s.emblem {
+		if n == 0 {
+			continue
+		}
+		var free []int
+		for _, m := range members {
+			if !slices.Contains(s.has[m], t) && held[m] < s.slots[m] {
+				free = append(free, m)
+			}
+		}
+		slices.SortStableFunc(free, func(a, b int) int { return cmp.Compare(s.slots[b]-held[b], s.slots[a]-held[a]) })
+		for _, m := range free[:min(n, len(free))] {
+			held[m]++
+			out = append(out, emblemHold{t, m})
+		}
+	}
+	return out
+}
+
+// team is the roster placements of members, each holding its emblems.
+func (s *compSearch) team(roster []sim.Placement, members []int) sim.Board {
+	board := make(sim.Board, 0, len(members))
+	at := map[int]int{}
+	for _, m := range members {
+		at[m] = len(board)
+		board = append(board, roster[m])
+	}
+	for _, h := range s.hold(members) {
+		p := &board[at[h.member]]
+		p.Items = append(slices.Clone(p.Items), items.Item{Name: s.traits[h.trait].Name + " Emblem", Kind: items.Emblem, Trait: s.traits[h.trait].Name})
+	}
+	return board
+}
+
+// holders lists roster indices carrying trait t.
+func (s *compSearch) holders(t int) []int {
+	var out []int
+	for i, ts := range s.has {
+		if slices.Contains(ts, t) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func (s *compSearch) run() {
+	counts := make([]int, len(s.traits))
+	cost := 0
+	for _, i := range s.must {
+		s.add(counts, i, 1)
+		cost += s.cost[i]
+	}
+	if s.cfg.CostCap > 0 && cost > s.cfg.CostCap {
+		return
+	}
+	s.dfs(0, slices.Clone(s.must), counts, cost)
+}
+
+func (s *compSearch) add(counts []int, i, d int) {
+	for _, t := range s.has[i] {
+		counts[t] += d
+	}
+}
+
+func (s *compSearch) dfs(k int, members, counts []int, cost int) {
+	s.nodes++
+	left := s.cfg.Level - len(members)
+	if left == 0 {
+		s.offer(members, counts, cost)
+		return
+	}
+	if s.nodes >= s.cfg.MaxNodes || len(s.order)-k < left {
+		return
+	}
+	floor := cost
+	for _, c := range s.cheap[k][:left] {
+		floor += c
+	}
+	if s.cfg.CostCap > 0 && floor > s.cfg.CostCap {
+		return
+	}
+	if len(s.best) == s.keep && rankComp(s.bound(k, members, counts, left, floor), s.best[len(s.best)-1]) >= 0 {
+		return
+	}
+	i := s.order[k]
+	if s.cfg.CostCap == 0 || cost+s.cost[i] <= s.cfg.CostCap {
+		s.add(counts, i, 1)
+		s.dfs(k+1, append(members, i), counts, cost+s.cost[i])
+		s.add(counts, i, -1)
+	}
+	s.dfs(k+1, members, counts, cost)
+}
+
+// bound is an optimistic score for filling left slots from order[k:], used to
+// cut branches that cannot beat the kept compositions. Each open slot adds at
+// most width[k] trait counts, and a trait gains at most min(champions left
+// with it, left). Every breakpoint still reachable is a step costing the
+// counts between it and the previous one; the cheapest steps that fit the
+// budget are an upper bound on the tiers gained (a relaxation that ignores
+// which champion carries which trait). Emblems count as held from the start,
+// up to the members lacking the trait with a free slot plus the open slots.
+func (s *compSearch) bound(k int, members, counts []int, left, floor int) compCandidate {
+	b := compCandidate{cost: floor}
+	var steps []int
+	for t, tr := range s.traits {
+		now := counts[t]
+		if s.emblem[t] > 0 {
+			room := left
+			for _, m := range members {
+				if s.slots[m] > 0 && !slices.Contains(s.has[m], t) {
+					room++
+				}
+			}
+			now += min(s.emblem[t], room)
+		}
+		reach := now + min(s.avail[k][t], left)
+		if tr.Tier(reach) > 0 {
+			b.active++
+		}
+		if !s.counts[t] {
+			continue
+		}
+		b.tiers += tr.Tier(now)
+		prev := now
+		for _, bp := range tr.Breakpoints {
+			if bp > now && bp <= reach {
+				steps = append(steps, bp-prev)
+				prev = bp
+			}
+		}
+	}
+	slices.Sort(steps)
+	budget := left * s.width[k]
+	for _, c := range steps {
+		if c > budget {
+			break
+		}
+		budget -= c
+		b.tiers++
+	}
+	return b
+}
+
+// rankComp orders compositions best-first: more tiers, more active traits,
+// then lower cost.
+func rankComp(a, b compCandidate) int {
+	return cmp.Or(cmp.Compare(b.tiers, a.tiers), cmp.Compare(b.active, a.active), cmp.Compare(a.cost, b.cost))
+}
+
+// offer records a complete composition if it makes the kept list.
+func (s *compSearch) offer(members, counts []int, cost int) {
+	c := compCandidate{cost: cost}
+	if len(s.cfg.Emblems) > 0 {
+		counts = slices.Clone(counts)
+		for _, h := range s.hold(members) {
+			counts[h.trait]++
+		}
+	}
+	for t, tr := range s.traits {
+		tier := tr.Tier(counts[t])
+		if tier > 0 {
+			c.active++
+		}
+		if s.counts[t] {
+			c.tiers += tier
+		}
+	}
+	if len(s.best) == s.keep && rankComp(c, s.best[len(s.best)-1]) >= 0 {
+		return
+	}
+	c.members = slices.Sorted(slices.Values(members))
+	i, _ := slices.BinarySearchFunc(s.best, c, func(a, b compCandidate) int { return cmp.Or(rankComp(a, b), 1) })
+	s.best = slices.Insert(s.best, i, c)
+	if len(s.best) > s.keep {
+		s.best = s.best[:s.keep]
+	}
+}
@@ -0,0 +1,259 @@
+package optimize
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+func compTraits() units.TraitsLoader {
+	return units.TraitsLoader{Traits: []units.Trait{
+		{Name: "Bastion", Kind: "class", Breakpoints: []int{2, 4, 6}},
+		{Name: "Sniper", Kind: "class", Breakpoints: []int{2, 3, 4}},
+		{Name: "Wraith", Kind: "origin", Breakpoints: []int{2, 4}},
+		{Name: "Crew", Kind: "origin", Breakpoints: []int{3}},
+		{Name: "The Champ", Kind: "origin", Breakpoints: []int{1}},
+	}}
+}
+
+func champ(t *testing.T, name string, cost int, traits ...string) sim.Placement {
+	t.Helper()
+	u := testUnit(t, name, units.WithHP(700), units.WithAD(50), units.WithAS(0.7))
+	u.Cost, u.Traits = cost, traits
+	return sim.Placement{Unit: u}
+}
+
+func compRoster(t *testing.T) []sim.Placement {
+	t.Helper()
+	return []sim.Placement{
+		champ(t, "A", 1, "Bastion", "Wraith"),
+		champ(t, "B", 1, "Bastion", "Crew"),
+		champ(t, "C", 2, "Sniper", "Wraith"),
+		champ(t, "D", 2, "Sniper", "Crew"),
+		champ(t, "E", 3, "Sniper", "Crew"),
+		champ(t, "F", 4, "Bastion"),
+		champ(t, "G", 5, "The Champ"),
+	}
+}
+
+// bruteForce scores every team of size n the slow way. Each emblem counts
+// when a member lacks its trait.
+func bruteForce(roster []sim.Placement, traits units.TraitsLoader, n, costCap int, emblems ...string) int {
+	best := -1
+	var rec func(start int, team []units.Unit, cost int)
+	rec = func(start int, team []units.Unit, cost int) {
+		if len(team) == n {
+			bonus := map[string]int{}
+			for _, e := range emblems {
+				lacking := 0
+				for _, u := range team {
+					if !slices.Contains(u.Traits, e) {
+						lacking++
+					}
+				}
+				if bonus[e] < lacking {
+					bonus[e]++
+				}
+			}
+			tiers := 0
+			for _, a := range traits.ActiveWith(team, bonus) {
+				if t, _ := traits.Lookup(a.Name); !t.Unique() {
+					tiers += a.Tier
+				}
+			}
+			if costCap == 0 || cost <= costCap {
+				best = max(best, tiers)
+			}
+			return
+		}
+		for i := start; i < len(roster); i++ {
+			rec(i+1, append(team, roster[i].Unit), cost+roster[i].Unit.Cost)
+		}
+	}
+	rec(0, nil, 0)
+	return best
+}
+
+func TestCompositions_MatchesBruteForce(t *testing.T) {
+	t.Parallel()
+	roster, traits := compRoster(t), compTraits()
+	for _, tc := range []struct{ level, costCap int }{{3, 0}, {4, 0}, {5, 0}, {4, 6}} {
+		res, err := Compositions(roster, traits, CompConfig{Level: tc.level, CostCap: tc.costCap, Top: 3})
+		if err != nil {
+			t.Fatalf("level %d: %v", tc.level, err)
+		}
+		if want := bruteForce(roster, traits, tc.level, tc.costCap); !res.Exhaustive || res.Best[0].Tiers != want {
+			t.Fatalf("level %d cap %d: best %+v, brute force %d", tc.level, tc.costCap, res.Best[0], want)
+		}
+		for i, c := range res.Best {
+			if len(c.Units) != tc.level || (tc.costCap > 0 && c.Cost > tc.costCap) || c.Rank != i+1 {
+				t.Fatalf("illegal composition %+v", c)
+			}
+			if i > 0 && c.Tiers > res.Best[i-1].Tiers {
+				t.Fatalf("not sorted: %+v", res.Best)
+			}
+		}
+	}
+}
+
+func TestCompositions_CostCapMatchesBruteForce(t *testing.T) {
+	t.Parallel()
+	roster, traits := compRoster(t), compTraits()
+	for level := 2; level <= 5; level++ {
+		for costCap := 2; costCap <= 12; costCap++ {
+			res, err := Compositions(roster, traits, CompConfig{Level: level, CostCap: costCap, Top: 3})
+			if err != nil {
+				t.Fatalf("level %d cap %d: %v", level, costCap, err)
+			}
+			want := bruteForce(roster, traits, level, costCap)
+			if want < 0 {
+				if len(res.Best) != 0 {
+					t.Fatalf("level %d cap %d: no team fits, got %+v", level, costCap, res.Best)
+				}
+				continue
+			}
+			if len(res.Best) == 0 || res.Best[0].Tiers != want {
+				t.Fatalf("level %d cap %d: best %+v, brute force %d", level, costCap, res.Best, want)
+			}
+			for _, c := range res.Best {
+				if c.Cost > costCap {
+					t.Fatalf("level %d cap %d: over-cap team %+v", level, costCap, c)
+				}
+			}
+		}
+	}
+}
+
+func TestCompositions_EmblemsReachBreakpoints(t *testing.T) {
+	t.Parallel()
+	roster, traits := compRoster(t), compTraits()
+	emblems := []string{"Wraith", "Wraith", "Sniper"}
+	for _, level := range []int{3, 4, 5} {
+		res, err := Compositions(roster, traits, CompConfig{Level: level, Emblems: emblems, Top: 3})
+		if err != nil {
+			t.Fatalf("level %d: %v", level, err)
+		}
+		if want := bruteForce(roster, traits, level, 0, emblems...); res.Best[0].Tiers != want {
+			t.Fatalf("level %d: best %+v, brute force %d", level, res.Best[0], want)
+		}
+		for _, c := range res.Best {
+			for _, e := range c.Emblems {
+				i := slices.IndexFunc(roster, func(p sim.Placement) bool { return p.Unit.Name == e.Unit })
+				if !slices.Contains(c.Units, e.Unit) || slices.Contains(roster[i].Unit.Traits, e.Trait) {
+					t.Fatalf("%s emblem on %s in %+v", e.Trait, e.Unit, c)
+				}
+			}
+		}
+	}
+	for _, bad := range []string{"Wrath", "The Champ"} {
+		if _, err := Compositions(roster, traits, CompConfig{Level: 3, Emblems: []string{bad}}); err == nil {
+			t.Fatalf("%s: expected an emblem error", bad)
+		}
+	}
+}
+
+func TestCompositions_EmblemsNeedFreeSlots(t *testing.T) {
+	t.Parallel()
+	traits := compTraits()
+	full := champ(t, "Full", 1, "Bastion")
+	for range items.MaxItems {
+		full.Items = append(full.Items, items.Item{Name: "Rod", Kind: items.Completed})
+	}
+	roster := []sim.Placement{full, champ(t, "C", 2, "Sniper", "Wraith"), champ(t, "D", 2, "Sniper", "Crew")}
+	cfg := CompConfig{Level: 2, Must: []string{"Full", "C"}, Emblems: []string{"Sniper", "Wraith"}, Top: 1}
+	res, err := Compositions(roster, traits, cfg)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if c := res.Best[0]; len(c.Emblems) != 0 || c.Tiers != 0 {
+		t.Fatalf("emblems counted on a member with no free slot: %+v", c)
+	}
+
+	roster[0].Items = roster[0].Items[:items.MaxItems-1]
+	s, err := newCompSearch(roster, traits, cfg)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if got := s.hold([]int{0, 1}); len(got) != 1 {
+		t.Fatalf("one free slot held %d emblems", len(got))
+	}
+	sc := sim.Scenario{Blue: sim.Formation(sim.TeamBlue, s.team(roster, []int{0, 1}))}
+	active, err := sc.Traits(sim.TeamBlue, traits)
+	if err != nil {
+		t.Fatalf("traits: %v", err)
+	}
+	i := slices.IndexFunc(active, func(a units.ActiveTrait) bool { return a.Name == "Sniper" })
+	if i < 0 || active[i].Count != 2 {
+		t.Fatalf("simulated team does not hold the Sniper emblem: %+v", active)
+	}
+}
+
+func TestCompositions_MustIncludeAndErrors(t *testing.T) {
+	t.Parallel()
+	roster, traits := compRoster(t), compTraits()
+	res, err := Compositions(roster, traits, CompConfig{Level: 4, Must: []string{"g"}, Top: 5})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	for _, c := range res.Best {
+		if !slices.Contains(c.Units, "G") {
+			t.Fatalf("must-include champion missing: %+v", c)
+		}
+	}
+	for name, cfg := range map[string]CompConfig{
+		"no level":     {},
+		"unknown must": {Level: 3, Must: []string{"Zed"}},
+		"too big":      {Level: 8},
+	} {
+		if _, err := Compositions(roster, traits, cfg); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+	bad := append(slices.Clone(roster), champ(t, "H", 1, "Sniperr"))
+	if _, err := Compositions(bad, traits, CompConfig{Level: 3}); err == nil {
+		t.Fatalf("expected an unknown trait error")
+	}
+}
+
+func TestCompositions_LargeRosterStaysResponsive(t *testing.T) {
+	t.Parallel()
+	traits := compTraits()
+	names := []string{"Bastion", "Sniper", "Wraith", "Crew"}
+	var roster []sim.Placement
+	for i := range 40 {
+		roster = append(roster, champ(t, fmt.Sprint("U", i), 1+i%5, names[i%4], names[(i/4)%4]))
+	}
+	res, err := Compositions(roster, traits, CompConfig{Level: 9})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if !res.Exhaustive || len(res.Best) != defaultTop {
+		t.Fatalf("level 9 over 40 champions: %d nodes, exhaustive=%v", res.Nodes, res.Exhaustive)
+	}
+}
+
+func TestCompositions_SimulatedAgainstReference(t *testing.T) {
+	t.Parallel()
+	roster, traits := compRoster(t), compTraits()
+	cfg := CompConfig{
+		Level: 3, Top: 2, Keep: 4,
+		Reference:        sim.Formation(sim.TeamRed, sim.Board{champ(t, "enemy", 1)}),
+		MonteCarloConfig: sim.MonteCarloConfig{Runs: 8},
+	}
+	a, err := Compositions(roster, traits, cfg)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(a.Best) != 2 || a.Best[0].Score == nil || a.Best[0].Score.Mean < a.Best[1].Score.Mean {
+		t.Fatalf("simulated ranking: %+v", a.Best)
+	}
+	cfg.Workers = 3
+	if b, _ := Compositions(roster, traits, cfg); !reflect.DeepEqual(a, b) {
+		t.Fatalf("results depend on worker count")
+	}
+}
@@ -0,0 +1,6 @@
+// Package optimize searches build decisions (items, compositions, positions)
+// by scoring candidates with the sim package: a cheap analytical estimate
+// prunes the space, then seeded Monte-Carlo runs rank the survivors. Every
+// candidate is simulated with the same seeds (common random numbers), so
+// differences between candidates are not drowned in run-to-run noise.
+package optimize
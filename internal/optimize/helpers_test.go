@@ -0,0 +1,47 @@
+package optimize
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+var testIDs = units.NewDeterministicIDs("optimize-test", 1)
+
+// testUnit builds a unit with a deterministic ID from its name; Range defaults to 1.
+func testUnit(t *testing.T, name string, opts ...units.Option) units.Unit {
+	t.Helper()
+	s, err := units.NewStats(append([]units.Option{units.WithRange(1)}, opts...)...)
+	if err != nil {
+		t.Fatalf("stats for %s: %v", name, err)
+	}
+	return units.Unit{ID: testIDs.UnitID(name, 0), Name: name, Stats: s}
+}
+
+func loadItems(t *testing.T) items.ItemsLoader {
+	t.Helper()
+	cfg, err := items.LoadItems("../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load items: %v", err)
+	}
+	return cfg
+}
+
+// duel is a ranged attack-damage carry (behind a tank) against two bruisers.
+func duel(t *testing.T) sim.Scenario {
+	t.Helper()
+	return sim.Scenario{
+		Name: "carry",
+		Blue: sim.Board{
+			{Unit: testUnit(t, "tank", units.WithHP(1500), units.WithArmor(60), units.WithAD(30), units.WithAS(0.6)), Pos: sim.Hex{Q: 0, R: 1}},
+			{Unit: testUnit(t, "carry", units.WithHP(600), units.WithAD(60), units.WithAS(0.8), units.WithRange(4)), Pos: sim.Hex{Q: 0, R: 0}},
+		},
+		Red: sim.Board{
+			{Unit: testUnit(t, "bruiser", units.WithHP(1200), units.WithArmor(40), units.WithAD(55), units.WithAS(0.7)), Pos: sim.Hex{Q: 0, R: 5}},
+			{Unit: testUnit(t, "brawler", units.WithHP(1000), units.WithArmor(30), units.WithAD(50), units.WithAS(0.7)), Pos: sim.Hex{Q: 1, R: 5}},
+		},
+		Config: sim.Config{Seed: 7},
+	}
+}
@@ -0,0 +1,256 @@
+package optimize
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// PlanConfig tunes PlanItems. Zero fields take the defaults.
+type PlanConfig struct {
+	Objective Objective // team objective (default dps)
+	Top       int       // plans returned (default 5)
+	Simulate  bool      // rank the best Keep plans by Monte-Carlo instead of the estimate
+	Keep      int       // plans simulated after analytical pruning (default 16)
+	sim.MonteCarloConfig
+}
+
+// Assignment is the items one unit receives in a plan.
+type Assignment struct {
+	Index int      `json:"index"` // position of the unit on the blue board
+	Unit  string   `json:"unit"`
+	Items []string `json:"items"`
+}
+
+// Plan is one way to slam a bag of components and hand the items out.
+type Plan struct {
+	Rank        int          `json:"rank"`
+	Assignments []Assignment `json:"assignments"` // units receiving items, board order
+	Leftover    []string     `json:"leftover"`    // components kept loose
+	Estimate    float64      `json:"estimate"`    // sum over the board of the analytical score
+	Score       *Score       `json:"score,omitempty"`
+}
+
+// PlanResult ranks item plans for a board.
+type PlanResult struct {
+	Objective Objective `json:"objective"`
+	Crafts    int       `json:"crafts"` // distinct sets of completed items the bag can make
+	Plans     int       `json:"plans"`  // legal (craft, assignment) pairs considered
+	Best      []Plan    `json:"best"`
+}
+
+// PlanItems enumerates every way to combine the bag of components into
+// completed items (any component may stay loose) and every legal way to hand
+// those items to the blue board of s (at most items.MaxItems per unit,
+// counting items already held, and one copy of a unique item). Plans are
+// ranked by the summed analytical estimate of the board against the red
+// board, or, with cfg.Simulate, by the team objective over seeded runs.
+func PlanItems(s sim.Scenario, bag []string, catalog items.ItemsLoader, cfg PlanConfig) (PlanResult, error) {
+	cfg, err := cfg.withDefaults()
+	if err != nil {
+		return PlanResult{}, err
+	}
+	if len(s.Blue) == 0 {
+		return PlanResult{}, fmt.Errorf("scenario %q: empty blue board", s.Name)
+	}
+	comps, err := bagComponents(catalog, bag)
+	if err != nil {
+		return PlanResult{}, err
+	}
+
+	defense := sim.MeanDefense(s.Red)
+	cache := map[string]float64{}
+	// unitScore is the estimate of blue unit i holding its current items plus extra.
+	unitScore := func(i int, extra []items.Item) (float64, bool) {
+		key := fmt.Sprint(i, items.Names(extra))
+		if v, ok := cache[key]; ok {
+			return v, true
+		}
+		p := s.Blue[i]
+		u, err := items.Equip(p.Unit, append(slices.Clone(p.Items), extra...)...)
+		if err != nil {
+			return 0, false
+		}
+		v := estimate(cfg.Objective, u, p, defense, s.Config.Timing)
+		cache[key] = v
+		return v, true
+	}
+
+	free := make([]int, len(s.Blue))
+	for i, p := range s.Blue {
+		free[i] = max(items.MaxItems-len(p.Items), 0)
+	}
+	keep := cfg.Top
+	if cfg.Simulate {
+		keep = cfg.Keep
+	}
+	out := PlanResult{Objective: cfg.Objective}
+	var best []Plan
+	for _, c := range crafts(catalog, comps) {
+		out.Crafts++
+		assign(free, c.made, func(owner []int) {
+			per := make([][]items.Item, len(s.Blue))
+			for k, it := range c.made {
+				per[owner[k]] = append(per[owner[k]], it)
+			}
+			plan := Plan{Leftover: items.Names(c.loose)}
+			for i, held := range per {
+				v, ok := unitScore(i, held)
+				if !ok {
+					return // a duplicate unique item
+				}
+				plan.Estimate += v
+				if len(held) > 0 {
+					plan.Assignments = append(plan.Assignments, Assignment{Index: i, Unit: s.Blue[i].Unit.Name, Items: items.Names(held)})
+				}
+			}
+			out.Plans++
+			if best = append(best, plan); len(best) > 4*keep {
+				best = topPlans(best, keep)
+			}
+		})
+	}
+	best = topPlans(best, keep)
+
+	if cfg.Simulate {
+		for i := range best {
+			score, _, err := simulateTeam(withPlan(s, catalog, best[i]), sim.TeamBlue, cfg.Objective, cfg.MonteCarloConfig)
+			if err != nil {
+				return PlanResult{}, err
+			}
+			best[i].Score = &score
+		}
+		slices.SortStableFunc(best, func(a, b Plan) int { return cfg.Objective.compare(a.Score.Mean, b.Score.Mean) })
+		best = best[:min(cfg.Top, len(best))]
+	}
+	for i := range best {
+		best[i].Rank = i + 1
+	}
+	out.Best = best
+	return out, nil
+}
+
+func (c PlanConfig) withDefaults() (PlanConfig, error) {
+	o, err := ParseObjective(string(c.Objective))
+	if err != nil {
+		return PlanConfig{}, err
+	}
+	c.Objective = o
+	if c.Top <= 0 {
+		c.Top = defaultTop
+	}
+	if c.Keep <= 0 {
+		c.Keep = defaultKeep
+	}
+	return c, nil
+}
+
+// topPlans keeps the n best plans by estimate; ties keep enumeration order.
+func topPlans(ps []Plan, n int) []Plan {
+	slices.SortStableFunc(ps, func(a, b Plan) int { return cmp.Compare(b.Estimate, a.Estimate) })
+	return ps[:min(n, len(ps))]
+}
+
+// withPlan returns a copy of s with the plan's items added to the blue board.
+func withPlan(s sim.Scenario, catalog items.ItemsLoader, p Plan) sim.Scenario {
+	s.Blue = slices.Clone(s.Blue)
+	for _, a := range p.Assignments {
+		i := a.Index
+		s.Blue[i].Items = slices.Clone(s.Blue[i].Items)
+		for _, name := range a.Items {
+			it, _ := catalog.Lookup(name)
+			s.Blue[i].Items = append(s.Blue[i].Items, it)
+		}
+	}
+	return s
+}
+
+// bagComponents resolves component names, sorted in catalog order.
+func bagComponents(catalog items.ItemsLoader, bag []string) ([]items.Item, error) {
+	order := map[string]int{}
+	for i, n := range catalog.Names() {
+		order[n] = i
+	}
+	out := make([]items.Item, 0, len(bag))
+	for _, n := range bag {
+		it, ok := catalog.Lookup(n)
+		if !ok || it.Kind != items.Component {
+			return nil, fmt.Errorf("bag: %q is not a component", n)
+		}
+		out = append(out, it)
+	}
+	slices.SortStableFunc(out, func(a, b items.Item) int { return cmp.Compare(order[a.Name], order[b.Name]) })
+	return out, nil
+}
+
+// craft is one way to combine a bag: the completed items and the loose rest.
+type craft struct {
+	made, loose []items.Item
+}
+
+// crafts lists every distinct way to pair up comps (sorted) into completed
+// items, leaving any number loose. Equal components are interchangeable, so
+// each distinct multiset of made items appears once, sorted by name.
+func crafts(catalog items.ItemsLoader, comps []items.Item) []craft {
+	var out []craft
+	seen := map[string]bool{}
+	var rec func(rest, made, loose []items.Item)
+	rec = func(rest, made, loose []items.Item) {
+		if len(rest) == 0 {
+			sorted := slices.SortedStableFunc(slices.Values(made), func(a, b items.Item) int { return strings.Compare(a.Name, b.Name) })
+			if key := strings.Join(items.Names(sorted), "|"); !seen[key] {
+				seen[key] = true
+				out = append(out, craft{made: sorted, loose: slices.Clone(loose)})
+			}
+			return
+		}
+		first, tail := rest[0], rest[1:]
+		rec(tail, made, append(loose, first))
+		for j, other := range tail {
+			if j > 0 && tail[j-1].Name == other.Name {
+				continue
+			}
+			it, ok := catalog.Combine(first.Name, other.Name)
+			if !ok {
+				continue
+			}
+			next := append(slices.Clone(tail[:j]), tail[j+1:]...)
+			rec(next, append(made, it), loose)
+		}
+	}
+	rec(comps, nil, nil)
+	return out
+}
+
+// assign calls fn with every owner vector mapping made[k] to a unit with a
+// free slot (free[u] slots each). Identical adjacent items get non-decreasing
+// owners, so swapping two copies of the same item is not counted twice.
+func assign(free []int, made []items.Item, fn func(owner []int)) {
+	owner := make([]int, len(made))
+	used := make([]int, len(free))
+	var rec func(k int)
+	rec = func(k int) {
+		if k == len(made) {
+			fn(owner)
+			return
+		}
+		start := 0
+		if k > 0 && made[k-1].Name == made[k].Name {
+			start = owner[k-1]
+		}
+		for u := start; u < len(free); u++ {
+			if used[u] == free[u] {
+				continue
+			}
+			owner[k] = u
+			used[u]++
+			rec(k + 1)
+			used[u]--
+		}
+	}
+	rec(0)
+}
@@ -0,0 +1,117 @@
+package optimize
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+func TestPlanItems_EnumeratesLegalPlans(t *testing.T) {
+	t.Parallel()
+	bag := []string{"B.F. Sword", "B.F. Sword", "Recurve Bow", "Chain Vest"}
+	res, err := PlanItems(duel(t), bag, loadItems(t), PlanConfig{Top: 1000})
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	// Crafts: nothing, one of 4 distinct pairs, or two items from
+	// {Sword+Sword, Bow+Vest} / {Sword+Bow, Sword+Vest} → 1 + 4 + 2.
+	if res.Crafts != 7 {
+		t.Fatalf("crafts = %d, want 7", res.Crafts)
+	}
+	// Assignments to 2 units: 1 + 4×2 + 2×4.
+	if res.Plans != 17 || len(res.Best) != 17 {
+		t.Fatalf("plans = %d (%d returned), want 17", res.Plans, len(res.Best))
+	}
+	catalog := loadItems(t)
+	for _, p := range res.Best {
+		var used []items.Item
+		for _, a := range p.Assignments {
+			for _, n := range a.Items {
+				it, _ := catalog.Lookup(n)
+				used = append(used, it)
+			}
+		}
+		got := append(items.Components(used), p.Leftover...)
+		slices.Sort(got)
+		want := slices.Sorted(slices.Values(bag))
+		if !slices.Equal(got, want) {
+			t.Fatalf("plan %+v uses components %v, bag is %v", p, got, want)
+		}
+	}
+	top := res.Best[0]
+	if len(top.Assignments) != 1 || top.Assignments[0].Unit != "carry" || len(top.Leftover) != 0 {
+		t.Fatalf("best plan should slam everything onto the carry: %+v", top)
+	}
+}
+
+func TestPlanItems_RespectsHeldItems(t *testing.T) {
+	t.Parallel()
+	catalog := loadItems(t)
+	s := duel(t)
+	warmog, _ := catalog.Lookup("Warmog's Armor")
+	s.Blue[1].Items = []items.Item{warmog, warmog, warmog} // carry is full
+	res, err := PlanItems(s, []string{"B.F. Sword", "B.F. Sword"}, catalog, PlanConfig{Top: 10})
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	for _, p := range res.Best {
+		for _, a := range p.Assignments {
+			if a.Unit == "carry" {
+				t.Fatalf("item given to a unit with no free slot: %+v", p)
+			}
+		}
+	}
+}
+
+func TestPlanItems_SimulatedDeterministic(t *testing.T) {
+	t.Parallel()
+	bag := []string{"B.F. Sword", "Recurve Bow", "Recurve Bow", "Giant's Belt"}
+	run := func(workers int) PlanResult {
+		res, err := PlanItems(duel(t), bag, loadItems(t), PlanConfig{
+			Simulate: true, Keep: 4, Top: 2, MonteCarloConfig: sim.MonteCarloConfig{Runs: 10, Workers: workers},
+		})
+		if err != nil {
+			t.Fatalf("plan: %v", err)
+		}
+		return res
+	}
+	a := run(1)
+	if len(a.Best) != 2 || a.Best[0].Score == nil || a.Best[0].Score.Mean < a.Best[1].Score.Mean {
+		t.Fatalf("simulated plans: %+v", a.Best)
+	}
+	if b := run(3); !reflect.DeepEqual(a, b) {
+		t.Fatalf("results depend on worker count")
+	}
+	if _, err := PlanItems(duel(t), []string{"Deathblade"}, loadItems(t), PlanConfig{}); err == nil {
+		t.Fatalf("expected an error for a completed item in the bag")
+	}
+}
+
+func TestPlanItems_DuplicateChampions(t *testing.T) {
+	t.Parallel()
+	catalog := loadItems(t)
+	s := duel(t)
+	twin := s.Blue[1]
+	twin.Pos = sim.Hex{Q: 1, R: 0}
+	s.Blue = append(s.Blue, twin)
+	res, err := PlanItems(s, []string{"B.F. Sword", "B.F. Sword", "Recurve Bow", "Recurve Bow"}, catalog, PlanConfig{Top: 1000})
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	for _, p := range res.Best {
+		if len(p.Assignments) != 2 || p.Assignments[0].Unit != "carry" || p.Assignments[1].Unit != "carry" {
+			continue
+		}
+		got := withPlan(s, catalog, p)
+		for _, a := range p.Assignments {
+			if n := len(got.Blue[a.Index].Items); n != len(a.Items) {
+				t.Fatalf("copy %d holds %d items, plan gives it %v", a.Index, n, a.Items)
+			}
+		}
+		return
+	}
+	t.Fatalf("no plan splits items between the two copies")
+}
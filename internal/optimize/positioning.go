@@ -0,0 +1,244 @@
+package optimize
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// PositionConfig tunes Positions. Zero fields take the defaults.
+type PositionConfig struct {
+	Objective            Objective // default win_rate
+	Carry                string    // score this blue unit instead of the team (required for dps)
+	Iterations           int       // neighbour layouts tried (default 200)
+	Temperature          float64   // initial annealing temperature in objective units; 0 → hill climbing
+	Seed                 uint64    // search seed (fights use Config.Seed + run)
+	sim.MonteCarloConfig           // runs per layout (default 200)
+}
+
+const (
+	defaultPositionIterations = 200
+	defaultPositionRuns       = 200
+)
+
+// Slot is one unit's hex in a layout.
+type Slot struct {
+	Unit string  `json:"unit"`
+	Pos  sim.Hex `json:"pos"`
+}
+
+// Layout is a blue board placement, in board order.
+type Layout []Slot
+
+func (l Layout) String() string {
+	parts := make([]string, len(l))
+	for i, s := range l {
+		parts[i] = fmt.Sprintf("%s@(%d,%d)", s.Unit, s.Pos.Q, s.Pos.R)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Distribution summarises per-run values.
+type Distribution struct {
+	Min    float64 `json:"min"`
+	Q1     float64 `json:"q1"`
+	Median float64 `json:"median"`
+	Q3     float64 `json:"q3"`
+	Max    float64 `json:"max"`
+}
+
+// PositionResult is the best layout found and how it compares to the start.
+type PositionResult struct {
+	Objective    Objective    `json:"objective"`
+	Carry        string       `json:"carry,omitempty"`
+	Start        Layout       `json:"start"`
+	StartScore   Score        `json:"start_score"`
+	Best         Layout       `json:"best"`
+	Score        Score        `json:"score"`
+	Distribution Distribution `json:"distribution"`
+	Samples      []float64    `json:"samples"`   // best layout, per run in seed order
+	Evaluated    int          `json:"evaluated"` // distinct layouts simulated
+	Accepted     int          `json:"accepted"`  // moves taken by the search
+}
+
+// Positions searches placements of the blue board of s on the blue half of
+// the grid against the fixed red board. Each step moves one unit to a free
+// hex or swaps two units; moves are kept when they do not worsen the score,
+// or with annealing probability while cfg.Temperature cools linearly to 0.
+// Every layout runs the same seeds, so differences come from the layout and
+// not from the dice. The start is the current layout, or Formation when a
+// blue unit sits off the blue side.
+func Positions(s sim.Scenario, cfg PositionConfig) (PositionResult, error) {
+	cfg, err := cfg.withDefaults()
+	if err != nil {
+		return PositionResult{}, err
+	}
+	if err := s.Validate(); err != nil {
+		return PositionResult{}, err
+	}
+	score := func(sc sim.Scenario) (Score, []float64, error) {
+		return simulateTeam(sc, sim.TeamBlue, cfg.Objective, cfg.MonteCarloConfig)
+	}
+	if cfg.Carry != "" {
+		idx, ok := s.Locate(cfg.Carry)
+		if !ok || idx >= len(s.Blue) {
+			return PositionResult{}, fmt.Errorf("scenario %q: no blue unit named %q", s.Name, cfg.Carry)
+		}
+		cfg.Carry = s.Blue[idx].Unit.Name
+		score = func(sc sim.Scenario) (Score, []float64, error) {
+			return simulate(sc, idx, cfg.Objective, cfg.MonteCarloConfig)
+		}
+	} else if cfg.Objective == ObjectiveDPS {
+		return PositionResult{}, fmt.Errorf("objective dps needs a carry")
+	}
+
+	hexes := sim.TeamHexes(sim.TeamBlue)
+	if len(s.Blue) > len(hexes) {
+		return PositionResult{}, fmt.Errorf("scenario %q: %d blue units do not fit on %d hexes", s.Name, len(s.Blue), len(hexes))
+	}
+	cur := make([]sim.Hex, len(s.Blue))
+	for i, p := range s.Blue {
+		if !sim.OnTeamSide(sim.TeamBlue, p.Pos) {
+			cur = positions(sim.Formation(sim.TeamBlue, s.Blue))
+			break
+		}
+		cur[i] = p.Pos
+	}
+
+	type eval struct {
+		score Score
+		xs    []float64
+	}
+	cache := map[string]eval{}
+	run := func(pos []sim.Hex) (eval, error) {
+		key := fmt.Sprint(pos)
+		if e, ok := cache[key]; ok {
+			return e, nil
+		}
+		sc, xs, err := score(withPositions(s, pos))
+		if err != nil {
+			return eval{}, err
+		}
+		cache[key] = eval{sc, xs}
+		return cache[key], nil
+	}
+
+	start, err := run(cur)
+	if err != nil {
+		return PositionResult{}, err
+	}
+	out := PositionResult{Objective: cfg.Objective, Carry: cfg.Carry, Start: layout(s.Blue, cur), StartScore: start.score}
+	best, bestEval, curEval := slices.Clone(cur), start, start
+	rng := rand.New(rand.NewPCG(cfg.Seed, 0x9e3779b97f4a7c15))
+	for it := range cfg.Iterations {
+		next := neighbour(rng, cur, hexes)
+		e, err := run(next)
+		if err != nil {
+			return PositionResult{}, err
+		}
+		delta := e.score.Mean - curEval.score.Mean
+		if cfg.Objective.LowerIsBetter() {
+			delta = -delta
+		}
+		temp := cfg.Temperature * (1 - float64(it)/float64(cfg.Iterations))
+		if delta >= 0 || (temp > 0 && rng.Float64() < math.Exp(delta/temp)) {
+			cur, curEval = next, e
+			out.Accepted++
+		}
+		if cfg.Objective.compare(curEval.score.Mean, bestEval.score.Mean) < 0 {
+			best, bestEval = slices.Clone(cur), curEval
+		}
+	}
+	out.Best = layout(s.Blue, best)
+	out.Score = bestEval.score
+	out.Samples = bestEval.xs
+	out.Distribution = newDistribution(bestEval.xs)
+	out.Evaluated = len(cache)
+	return out, nil
+}
+
+func (c PositionConfig) withDefaults() (PositionConfig, error) {
+	if strings.TrimSpace(string(c.Objective)) == "" {
+		c.Objective = ObjectiveWinRate
+	}
+	o, err := ParseObjective(string(c.Objective))
+	if err != nil {
+		return PositionConfig{}, err
+	}
+	c.Objective = o
+	if c.Iterations <= 0 {
+		c.Iterations = defaultPositionIterations
+	}
+	if c.Temperature < 0 {
+		return PositionConfig{}, fmt.Errorf("temperature: must be >= 0, got %g", c.Temperature)
+	}
+	if c.Runs <= 0 {
+		c.Runs = defaultPositionRuns
+	}
+	return c, nil
+}
+
+// neighbour moves one unit to a free hex or swaps it with another unit.
+func neighbour(rng *rand.Rand, cur, hexes []sim.Hex) []sim.Hex {
+	next := slices.Clone(cur)
+	i := rng.IntN(len(next))
+	h := hexes[rng.IntN(len(hexes))]
+	for h == next[i] {
+		if len(hexes) == 1 {
+			return next
+		}
+		h = hexes[rng.IntN(len(hexes))]
+	}
+	if j := slices.Index(next, h); j >= 0 {
+		next[j] = next[i]
+	}
+	next[i] = h
+	return next
+}
+
+func positions(b sim.Board) []sim.Hex {
+	out := make([]sim.Hex, len(b))
+	for i, p := range b {
+		out[i] = p.Pos
+	}
+	return out
+}
+
+// withPositions returns a copy of s with the blue board moved to pos.
+func withPositions(s sim.Scenario, pos []sim.Hex) sim.Scenario {
+	s.Blue = slices.Clone(s.Blue)
+	for i := range s.Blue {
+		s.Blue[i].Pos = pos[i]
+	}
+	return s
+}
+
+func layout(b sim.Board, pos []sim.Hex) Layout {
+	out := make(Layout, len(b))
+	for i, p := range b {
+		out[i] = Slot{Unit: p.Unit.Name, Pos: pos[i]}
+	}
+	return out
+}
+
+// newDistribution reports quartiles by linear interpolation.
+func newDistribution(xs []float64) Distribution {
+	if len(xs) == 0 {
+		return Distribution{}
+	}
+	s := slices.SortedFunc(slices.Values(xs), cmp.Compare[float64])
+	q := func(p float64) float64 {
+		f := p * float64(len(s)-1)
+		lo := int(f)
+		if lo+1 >= len(s) {
+			return s[lo]
+		}
+		return s[lo] + (f-float64(lo))*(s[lo+1]-s[lo])
+	}
+	return Distribution{Min: s[0], Q1: q(0.25), Median: q(0.5), Q3: q(0.75), Max: s[len(s)-1]}
+}
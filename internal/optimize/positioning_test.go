@@ -0,0 +1,74 @@
+package optimize
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// exposed puts the carry on the front line and the tank behind it.
+func exposed(t *testing.T) sim.Scenario {
+	t.Helper()
+	s := duel(t)
+	s.Blue[0].Pos = sim.Cell(3, 0)
+	s.Blue[1].Pos = sim.Cell(3, 3)
+	s.Red[0].Pos = sim.Cell(3, 4)
+	s.Red[1].Pos = sim.Cell(4, 4)
+	return s
+}
+
+func TestPositions_ProtectsCarry(t *testing.T) {
+	t.Parallel()
+	cfg := PositionConfig{Objective: ObjectiveSurvival, Carry: "carry", Iterations: 60, Seed: 1, MonteCarloConfig: sim.MonteCarloConfig{Runs: 40}}
+	res, err := Positions(exposed(t), cfg)
+	if err != nil {
+		t.Fatalf("positions: %v", err)
+	}
+	if res.Score.Mean <= res.StartScore.Mean {
+		t.Fatalf("best survival %.2f should beat the start %.2f", res.Score.Mean, res.StartScore.Mean)
+	}
+	if res.Best[1].Pos.R >= res.Start[1].Pos.R {
+		t.Fatalf("carry should move back from row %d, best layout %v", res.Start[1].Pos.R, res.Best)
+	}
+	for _, s := range res.Best {
+		if !sim.OnTeamSide(sim.TeamBlue, s.Pos) {
+			t.Fatalf("%s placed off the blue side: %v", s.Unit, res.Best)
+		}
+	}
+	if len(res.Samples) != 40 || res.Distribution.Min > res.Distribution.Median || res.Distribution.Median > res.Distribution.Max {
+		t.Fatalf("bad distribution %+v over %d samples", res.Distribution, len(res.Samples))
+	}
+
+	again, err := Positions(exposed(t), cfg)
+	if err != nil {
+		t.Fatalf("positions: %v", err)
+	}
+	if !reflect.DeepEqual(res, again) {
+		t.Fatalf("search is not deterministic:\n%v\n%v", res.Best, again.Best)
+	}
+}
+
+func TestPositions_Errors(t *testing.T) {
+	t.Parallel()
+	cases := map[string]PositionConfig{
+		"dps without carry": {Objective: ObjectiveDPS},
+		"red carry":         {Carry: "bruiser"},
+		"unknown carry":     {Carry: "nobody"},
+		"negative temp":     {Temperature: -1},
+	}
+	for name, cfg := range cases {
+		if _, err := Positions(duel(t), cfg); err == nil {
+			t.Fatalf("%s: want an error", name)
+		}
+	}
+}
+
+func TestNewDistribution(t *testing.T) {
+	t.Parallel()
+	got := newDistribution([]float64{4, 1, 3, 2, 5})
+	want := Distribution{Min: 1, Q1: 2, Median: 3, Q3: 4, Max: 5}
+	if got != want {
+		t.Fatalf("distribution = %+v, want %+v", got, want)
+	}
+}
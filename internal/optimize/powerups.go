@@ -0,0 +1,79 @@
+package optimize
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// PowerUpConfig tunes ComparePowerUps. Zero fields take the defaults.
+type PowerUpConfig struct {
+	Objective Objective // default dps
+	sim.MonteCarloConfig
+}
+
+// PowerUpScore is one power-up's result for the unit.
+type PowerUpScore struct {
+	Rank    int     `json:"rank"`
+	PowerUp string  `json:"power_up"`
+	Score   Score   `json:"score"`
+	Delta   float64 `json:"delta"` // mean minus the mean without a power-up
+}
+
+// Skipped is a power-up the unit may not take.
+type Skipped struct {
+	PowerUp string `json:"power_up"`
+	Reason  string `json:"reason"`
+}
+
+// PowerUpResult ranks power-ups for one unit.
+type PowerUpResult struct {
+	Unit       string         `json:"unit"`
+	Objective  Objective      `json:"objective"`
+	Baseline   Score          `json:"baseline"` // without a power-up
+	Ranked     []PowerUpScore `json:"ranked"`   // best first
+	Ineligible []Skipped      `json:"ineligible,omitempty"`
+}
+
+// ComparePowerUps simulates the named unit of s once per eligible power-up
+// and once without, all with the same seeds, and ranks the power-ups by the
+// objective. The unit must not already carry a power-up.
+func ComparePowerUps(s sim.Scenario, unit string, list []powerups.PowerUp, cfg PowerUpConfig) (PowerUpResult, error) {
+	o, err := ParseObjective(string(cfg.Objective))
+	if err != nil {
+		return PowerUpResult{}, err
+	}
+	idx, ok := s.Locate(unit)
+	if !ok {
+		return PowerUpResult{}, fmt.Errorf("scenario %q: no unit named %q", s.Name, unit)
+	}
+	base, _ := s.PlacementAt(idx)
+	if base.PowerUp != nil || base.Unit.PowerUp != "" {
+		return PowerUpResult{}, fmt.Errorf("%s already has a power-up", base.Unit.Name)
+	}
+
+	out := PowerUpResult{Unit: base.Unit.Name, Objective: o}
+	if out.Baseline, _, err = simulate(s, idx, o, cfg.MonteCarloConfig); err != nil {
+		return PowerUpResult{}, err
+	}
+	for _, pu := range list {
+		if err := pu.Eligible.Check(base.Unit); err != nil {
+			out.Ineligible = append(out.Ineligible, Skipped{PowerUp: pu.Name, Reason: err.Error()})
+			continue
+		}
+		p := base
+		p.PowerUp = &pu
+		score, _, err := simulate(s.WithPlacement(idx, p), idx, o, cfg.MonteCarloConfig)
+		if err != nil {
+			return PowerUpResult{}, fmt.Errorf("%s: %w", pu.Name, err)
+		}
+		out.Ranked = append(out.Ranked, PowerUpScore{PowerUp: pu.Name, Score: score, Delta: score.Mean - out.Baseline.Mean})
+	}
+	slices.SortStableFunc(out.Ranked, func(a, b PowerUpScore) int { return o.compare(a.Score.Mean, b.Score.Mean) })
+	for i := range out.Ranked {
+		out.Ranked[i].Rank = i + 1
+	}
+	return out, nil
+}
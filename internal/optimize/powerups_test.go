@@ -0,0 +1,37 @@
+package optimize
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+func TestComparePowerUps_RanksEligible(t *testing.T) {
+	t.Parallel()
+	s := duel(t)
+	s.Blue[1].Unit.Cost = 4
+	s.Blue[1].Unit.Roles = []string{"Attack Marksman"}
+	list := []powerups.PowerUp{
+		{Name: "Armor", Eligible: powerups.Eligibility{Roles: []string{"marksman"}}, Stats: map[string]float64{"armor": 5}},
+		{Name: "Ballistic", Eligible: powerups.Eligibility{Roles: []string{"marksman"}}, Percent: map[string]float64{"attack_speed": 0.5}},
+		{Name: "Tiny Tank", Eligible: powerups.Eligibility{Roles: []string{"tank"}}, Stats: map[string]float64{"HP": 500}},
+		{Name: "Cheap", Eligible: powerups.Eligibility{Costs: []int{1}}, Stats: map[string]float64{"AD": 100}},
+	}
+	res, err := ComparePowerUps(s, "carry", list, PowerUpConfig{MonteCarloConfig: sim.MonteCarloConfig{Runs: 40}})
+	if err != nil {
+		t.Fatalf("compare: %v", err)
+	}
+	if len(res.Ranked) != 2 || len(res.Ineligible) != 2 {
+		t.Fatalf("ranked %+v, ineligible %+v", res.Ranked, res.Ineligible)
+	}
+	best := res.Ranked[0]
+	if best.PowerUp != "Ballistic" || best.Rank != 1 || best.Delta <= 0 {
+		t.Fatalf("Ballistic should rank first with a DPS gain: %+v (baseline %.2f)", best, res.Baseline.Mean)
+	}
+
+	s.Blue[1].PowerUp = &list[0]
+	if _, err := ComparePowerUps(s, "carry", list, PowerUpConfig{}); err == nil {
+		t.Fatalf("expected an error for a unit that already has a power-up")
+	}
+}
@@ -0,0 +1,154 @@
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/sim"
+)
+
+// Objective is what a search optimizes for its focus unit.
+type Objective string
+
+const (
+	ObjectiveDPS      Objective = "dps"      // mean DPS (higher is better)
+	ObjectiveTTK      Objective = "ttk"      // seconds to wipe the enemy board; losses and timeouts count as the timeout (lower is better)
+	ObjectiveSurvival Objective = "survival" // seconds alive (higher is better)
+	ObjectiveWinRate  Objective = "win_rate" // fraction of runs won (higher is better)
+)
+
+// ParseObjective accepts an objective name in any casing ("" → dps).
+func ParseObjective(s string) (Objective, error) {
+	switch o := Objective(strings.ToLower(strings.TrimSpace(s))); o {
+	case "":
+		return ObjectiveDPS, nil
+	case ObjectiveDPS, ObjectiveTTK, ObjectiveSurvival, ObjectiveWinRate:
+		return o, nil
+	}
+	return "", fmt.Errorf("unknown objective %q (want dps, ttk, survival or win_rate)", s)
+}
+
+// LowerIsBetter reports whether smaller scores win.
+func (o Objective) LowerIsBetter() bool { return o == ObjectiveTTK }
+
+// metric reads the objective for fighter idx (blue then red) from one run.
+func (o Objective) metric(r sim.Result, idx int, timeout float64) float64 {
+	f := r.Fighters[idx]
+	switch o {
+	case ObjectiveTTK:
+		if r.Winner == f.Team {
+			return r.Duration
+		}
+		return timeout
+	case ObjectiveSurvival:
+		return f.TimeAlive
+	case ObjectiveWinRate:
+		return won(r, f.Team)
+	}
+	return f.DPS
+}
+
+// teamMetric reads the objective for a whole team from one run: team DPS,
+// time to wipe the enemy board, the mean time alive of its units, or a win.
+func (o Objective) teamMetric(r sim.Result, team int, timeout float64) float64 {
+	switch o {
+	case ObjectiveTTK:
+		if r.Winner == team {
+			return r.Duration
+		}
+		return timeout
+	case ObjectiveSurvival:
+		var sum float64
+		var n int
+		for _, f := range r.Fighters {
+			if f.Team == team {
+				sum += f.TimeAlive
+				n++
+			}
+		}
+		return sum / float64(max(n, 1))
+	case ObjectiveWinRate:
+		return won(r, team)
+	}
+	for _, t := range r.Teams {
+		if t.Team == team {
+			return t.DPS
+		}
+	}
+	return 0
+}
+
+func won(r sim.Result, team int) float64 {
+	if r.Winner == team {
+		return 1
+	}
+	return 0
+}
+
+// compare orders two means best-first.
+func (o Objective) compare(a, b float64) int {
+	if o.LowerIsBetter() {
+		a, b = b, a
+	}
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return 0
+}
+
+// Score is a Monte-Carlo estimate with a normal-approximation 95% confidence
+// interval of the mean.
+type Score struct {
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	Low    float64 `json:"ci_low"`
+	High   float64 `json:"ci_high"`
+	Runs   int     `json:"runs"`
+}
+
+func newScore(xs []float64) Score {
+	s := Score{Runs: len(xs)}
+	if len(xs) == 0 {
+		return s
+	}
+	for _, x := range xs {
+		s.Mean += x / float64(len(xs))
+	}
+	if len(xs) > 1 {
+		var ss float64
+		for _, x := range xs {
+			ss += (x - s.Mean) * (x - s.Mean)
+		}
+		s.StdDev = math.Sqrt(ss / float64(len(xs)-1))
+	}
+	half := 1.96 * s.StdDev / math.Sqrt(float64(len(xs)))
+	s.Low, s.High = s.Mean-half, s.Mean+half
+	return s
+}
+
+// simulate scores scenario s for fighter idx over cfg.Runs seeded runs and
+// returns the per-run values in run order.
+func simulate(s sim.Scenario, idx int, o Objective, cfg sim.MonteCarloConfig) (Score, []float64, error) {
+	return simulateWith(s, cfg, func(r sim.Result) float64 { return o.metric(r, idx, s.Config.Timeout()) })
+}
+
+// simulateTeam scores scenario s for a whole team over cfg.Runs seeded runs.
+func simulateTeam(s sim.Scenario, team int, o Objective, cfg sim.MonteCarloConfig) (Score, []float64, error) {
+	return simulateWith(s, cfg, func(r sim.Result) float64 { return o.teamMetric(r, team, s.Config.Timeout()) })
+}
+
+func simulateWith(s sim.Scenario, cfg sim.MonteCarloConfig, metric func(sim.Result) float64) (Score, []float64, error) {
+	results, err := sim.RunMany(s, cfg)
+	if err != nil {
+		return Score{}, nil, err
+	}
+	xs := make([]float64, len(results))
+	for i, r := range results {
+		xs[i] = metric(r)
+	}
+	return newScore(xs), xs, nil
+}
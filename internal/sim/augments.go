@@ -0,0 +1,106 @@
+package sim
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// AugmentHooks turns the hooks of augments into combat hooks tagged
+// SourceAugment and named after the augment.
+func AugmentHooks(augs ...augments.Augment) []CombatHook {
+	var out []CombatHook
+	for _, a := range augs {
+		out = append(out, combatHooks(a.Name, SourceAugment, a.Hooks)...)
+	}
+	return out
+}
+
+// combatHooks turns config hooks into combat hooks named name.
+func combatHooks(name string, kind SourceKind, hs []augments.Hook) []CombatHook {
+	out := make([]CombatHook, 0, len(hs))
+	for _, h := range hs {
+		out = append(out, CombatHook{Name: name, Kind: kind, Trigger: Trigger(h.Trigger), Every: h.Every, Fn: hookAction(name, h)})
+	}
+	return out
+}
+
+func hookAction(name string, h augments.Hook) func(c *HookContext) {
+	switch h.Action {
+	case augments.ActionDamage:
+		return func(c *HookContext) {
+			if t := c.Target(); t != nil {
+				c.Damage(t, h.DamageType, h.Amount)
+			}
+		}
+	case augments.ActionHeal:
+		return func(c *HookContext) { c.Engine.Heal(c.Self, c.Self, h.Amount, name) }
+	case augments.ActionShield:
+		return func(c *HookContext) {
+			c.Engine.AddShield(c.Self, c.Self, Shield{Name: name, Amount: h.Amount, Duration: h.Duration})
+		}
+	case augments.ActionMana:
+		return func(c *HookContext) { c.GainMana(h.Amount) }
+	case augments.ActionBuff:
+		eff := Effect{Name: name, Duration: h.Duration, Stacking: StackAdd, MaxStacks: h.MaxStacks}
+		for k, v := range h.Stats {
+			if path, ok := units.ResolveStatPath(k); ok {
+				eff.Modifiers = append(eff.Modifiers, Modifier{Path: path, Add: v})
+			}
+		}
+		for k, v := range h.Percent {
+			if path, ok := units.ResolveStatPath(k); ok {
+				eff.Modifiers = append(eff.Modifiers, Modifier{Path: path, Mult: v})
+			}
+		}
+		slices.SortStableFunc(eff.Modifiers, func(a, b Modifier) int { return cmp.Compare(a.Path, b.Path) }) // map order never shows
+		return func(c *HookContext) { c.Engine.ApplyEffect(c.Self, c.Self, eff) }
+	}
+	return nil
+}
+
+// withAugments returns b with the player's augments applied: granted items
+// go to the first unit (board order) that can still hold them, then every
+// unit is equipped and receives the augment stats and hooks.
+func withAugments(b Board, augs []augments.Augment) (Board, error) {
+	b = slices.Clone(b)
+	for _, a := range augs {
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		if len(a.Granted) != len(a.Items) {
+			return nil, fmt.Errorf("augment %q: items %v not resolved against the item catalog", a.Name, a.Items)
+		}
+		for _, it := range a.Granted {
+			i := slices.IndexFunc(b, func(p Placement) bool {
+				_, err := items.Equip(p.Unit, append(slices.Clone(p.Items), it)...)
+				return err == nil
+			})
+			if i < 0 {
+				return nil, fmt.Errorf("augment %q: no unit can hold %s", a.Name, it.Name)
+			}
+			b[i].Items = append(slices.Clone(b[i].Items), it)
+		}
+	}
+	if len(augs) == 0 {
+		return b, nil
+	}
+	hooks := AugmentHooks(augs...)
+	for i, p := range b {
+		p, err := p.equipped()
+		if err != nil {
+			return nil, err
+		}
+		if p.Unit, err = augments.Apply(p.Unit, augs...); err != nil {
+			return nil, err
+		}
+		p.Items, p.PowerUp = nil, nil // already applied
+		p.Hooks = append(slices.Clone(p.Hooks), hooks...)
+		b[i] = p
+	}
+	return b, nil
+}
@@ -0,0 +1,91 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestCombatHooks_Triggers(t *testing.T) {
+	t.Parallel()
+	var start, casts, ticks []float64
+	rec := func(ts *[]float64) func(c *HookContext) {
+		return func(c *HookContext) { *ts = append(*ts, c.Engine.Now()) }
+	}
+	e, _, _ := caster(t, 0, units.WithMana(0, 30, 30, 0, 0))
+	WithHooks(
+		CombatHook{Name: "start", Trigger: TriggerStart, Fn: rec(&start)},
+		CombatHook{Name: "cast", Trigger: TriggerCast, Fn: rec(&casts)},
+		CombatHook{Name: "tick", Trigger: TriggerEvery, Every: 2, Fn: rec(&ticks)},
+	)(e.fighters[0])
+	e.RunUntil(5)
+	if !sameTimes(start, []float64{0}) || !sameTimes(casts, []float64{0}) || !sameTimes(ticks, []float64{2, 4}) {
+		t.Fatalf("start %v, casts %v, ticks %v", start, casts, ticks)
+	}
+}
+
+func TestScenarioAugments_ApplyToEveryUnit(t *testing.T) {
+	t.Parallel()
+	catalog, err := items.LoadItems("../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load items: %v", err)
+	}
+	cfg := augments.AugmentsLoader{Augments: []augments.Augment{
+		{Name: "Stand United", Tier: augments.Gold, Stats: map[string]float64{"AD": 10}},
+		{Name: "Pandora's Items", Tier: augments.Gold, Items: []string{"Sterak's Gage"}},
+		{Name: "Spoils", Tier: augments.Gold, Hooks: []augments.Hook{{Trigger: augments.StartOfCombat, Action: augments.ActionShield, Amount: 100}}},
+		{Name: "Arcane Storm", Tier: augments.Prismatic, Hooks: []augments.Hook{{Trigger: augments.Every, Every: 1, Action: augments.ActionDamage, DamageType: units.Magic, Amount: 30}}},
+	}}
+	if err := cfg.ResolveItems(catalog); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	full, _ := catalog.Lookup("Deathblade")
+	s := Scenario{
+		Name: "augments",
+		Blue: Board{
+			{Unit: testUnit(t, "full", units.WithAD(50), units.WithAS(1)), Pos: Hex{0, 0}, Items: []items.Item{full, full, full}},
+			{Unit: testUnit(t, "empty", units.WithAD(50), units.WithAS(1)), Pos: Hex{1, 0}},
+		},
+		Red:      Board{{Unit: dummy(t, "dummy"), Pos: Hex{0, 1}}},
+		Config:   Config{MaxTime: 5},
+		Augments: [2][]augments.Augment{TeamBlue: cfg.Augments},
+	}
+	e, err := s.Engine()
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	empty := e.Fighters()[1]
+	// Sterak's Gage skips the full unit: 50 × 1.35 + 10.
+	if ad := empty.Unit.Stats.Offense.AD; !near(ad, 77.5) {
+		t.Fatalf("AD of the unit holding the granted item = %v, want 77.5", ad)
+	}
+	if ad := e.Fighters()[0].Unit.Stats.Offense.AD; ad < 60 {
+		t.Fatalf("augment stats missing on the full unit: AD %v", ad)
+	}
+	if e.Fighters()[2].Unit.Stats.Offense.AD >= 10 {
+		t.Fatalf("blue augments leaked onto red")
+	}
+	e.Run()
+	if n := countEvents(e.Log(), EventShieldApplied, "Spoils"); n != 2 {
+		t.Fatalf("start-of-combat shield on %d units, want 2", n)
+	}
+	res := e.Result()
+	for _, f := range res.Fighters[:2] {
+		var storm float64
+		for _, row := range f.Breakdown {
+			if row.Source == SourceAugment && row.Label == "Arcane Storm" && row.Type == units.Magic {
+				storm += row.Amount
+			}
+		}
+		if storm <= 0 {
+			t.Fatalf("%s: no augment damage in %v", f.Name, f.Breakdown)
+		}
+	}
+
+	s.Augments[TeamBlue] = append(s.Augments[TeamBlue], cfg.Augments[1], cfg.Augments[1], cfg.Augments[1])
+	if err := s.Validate(); err == nil {
+		t.Fatalf("expected an error when no unit can hold a granted item")
+	}
+}
@@ -0,0 +1,91 @@
+package sim
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// BreakdownKey identifies one line of a damage breakdown.
+type BreakdownKey struct {
+	Source SourceKind       `json:"source"`
+	Label  string           `json:"label,omitempty"` // item/trait/effect name; "" for plain attacks and spells
+	Type   units.DamageType `json:"damage_type"`
+}
+
+// BreakdownRow is a breakdown line: absolute damage and its share of the total.
+type BreakdownRow struct {
+	BreakdownKey
+	Amount float64 `json:"amount"`
+	Share  float64 `json:"share"` // fraction of the total, in [0,1]
+}
+
+// Breakdown is a unit's damage split, largest line first.
+type Breakdown []BreakdownRow
+
+// newBreakdown builds rows from summed amounts, each multiplied by scale
+// (1 for a single fight, 1/runs for a Monte-Carlo mean).
+func newBreakdown(sums map[BreakdownKey]float64, scale float64) Breakdown {
+	total := 0.0
+	for _, v := range sums {
+		total += v
+	}
+	rows := make(Breakdown, 0, len(sums))
+	for k, v := range sums {
+		row := BreakdownRow{BreakdownKey: k, Amount: v * scale}
+		if total > 0 {
+			row.Share = v / total
+		}
+		rows = append(rows, row)
+	}
+	slices.SortFunc(rows, func(a, b BreakdownRow) int {
+		if c := cmp.Compare(b.Amount, a.Amount); c != 0 {
+			return c
+		}
+		return cmp.Or(cmp.Compare(a.Source, b.Source), cmp.Compare(a.Label, b.Label), cmp.Compare(a.Type, b.Type))
+	})
+	return rows
+}
+
+// Total is the sum of every line.
+func (b Breakdown) Total() float64 {
+	t := 0.0
+	for _, r := range b {
+		t += r.Amount
+	}
+	return t
+}
+
+// BySource sums the breakdown per source kind.
+func (b Breakdown) BySource() map[SourceKind]float64 {
+	out := map[SourceKind]float64{}
+	for _, r := range b {
+		out[r.Source] += r.Amount
+	}
+	return out
+}
+
+// ByType sums the breakdown per damage type.
+func (b Breakdown) ByType() map[units.DamageType]float64 {
+	out := map[units.DamageType]float64{}
+	for _, r := range b {
+		out[r.Type] += r.Amount
+	}
+	return out
+}
+
+// Table renders the breakdown as an aligned text table.
+func (b Breakdown) Table() string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "source\tlabel\ttype\tdamage\tshare\t")
+	for _, r := range b {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.0f\t%.1f%%\t\n", r.Source, r.Label, r.Type, r.Amount, 100*r.Share)
+	}
+	w.Flush()
+	return sb.String()
+}
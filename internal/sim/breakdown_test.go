@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestBreakdown_SourcesAndTypes(t *testing.T) {
+	t.Parallel()
+	e := New(Config{MaxTime: 10})
+	bolt := testAbility(0) // true damage
+	a := e.Add(testUnit(t, "carry", units.WithAD(100), units.WithAS(1), units.WithCritChance(0), units.WithMana(0, 20, 0, 0, 10)),
+		0, Hex{0, 0}, WithAbility(bolt, 1),
+		WithOnHit(
+			OnHitFunc{HookName: "Rageblade", Kind: SourceItem, Fn: func(h *Hit) { h.Damage(units.Magic, 10) }},
+			EffectOnHit("Sorcerer", Burn(DefaultBurn, 3)),
+		))
+	e.Add(dummy(t, "dummy"), 1, Hex{0, 1})
+	e.RunUntil(2.5)
+
+	b := e.Result().Fighters[a.index].Breakdown
+	by := b.BySource()
+	for _, k := range []SourceKind{SourceAutoAttack, SourceAbility, SourceItem, SourceBurn} {
+		if by[k] <= 0 {
+			t.Fatalf("missing %s damage in %v", k, by)
+		}
+	}
+	types := b.ByType()
+	if types[units.Physical] != 300 || types[units.True] != 100+by[SourceBurn] || types[units.Magic] != 30 {
+		t.Fatalf("by type: %v", types)
+	}
+	share := 0.0
+	for i, r := range b {
+		share += r.Share
+		if i > 0 && r.Amount > b[i-1].Amount {
+			t.Fatalf("rows must be sorted by amount")
+		}
+	}
+	if !near(share, 1) || !near(b.Total(), a.Tally().DamageDealt) {
+		t.Fatalf("shares sum to %v, total %v vs %v", share, b.Total(), a.Tally().DamageDealt)
+	}
+	if tbl := b.Table(); !strings.Contains(tbl, "Rageblade") || !strings.Contains(tbl, "%") {
+		t.Fatalf("table:\n%s", tbl)
+	}
+}
+
+func TestMonteCarlo_DeterministicAcrossWorkers(t *testing.T) {
+	t.Parallel()
+	s := testScenario(t, 100)
+	one, err := MonteCarlo(s, MonteCarloConfig{Runs: 40, Workers: 1})
+	if err != nil {
+		t.Fatalf("mc: %v", err)
+	}
+	many, _ := MonteCarlo(s, MonteCarloConfig{Runs: 40, Workers: 8})
+	if !reflect.DeepEqual(one, many) {
+		t.Fatalf("results must not depend on the worker count")
+	}
+	if one.Runs != 40 || !near(one.WinRate[TeamBlue]+one.WinRate[TeamRed], 1) {
+		t.Fatalf("win rates: %v", one.WinRate)
+	}
+	carry := one.Units[1]
+	if carry.Name != "carry" || !near(carry.Breakdown.Total(), carry.DamageDealt) {
+		t.Fatalf("mean breakdown should sum to mean damage: %v vs %v", carry.Breakdown.Total(), carry.DamageDealt)
+	}
+}
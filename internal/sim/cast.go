@@ -0,0 +1,102 @@
+package sim
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+)
+
+// canCast reports whether f has a spell, full mana and is free to cast.
+func (f *Fighter) canCast() bool {
+	full := f.stats.Resource.ManaMax
+	return f.ability != nil && full > 0 && f.mana >= full && !f.hasCC(CCSilence)
+}
+
+// cast spends f's mana and locks it for CastTime; the spell lands at the end.
+// Hard CC during the lock interrupts it (see ccChanged).
+func (e *Engine) cast(f, t *Fighter) {
+	a := *f.ability
+	f.mana = f.stats.Resource.ManaMin
+	f.casting = true
+	f.castGen++
+	gen := f.castGen
+	f.castStart = e.now
+	f.actGen++ // the action loop waits for the cast
+	e.emit(Event{Kind: EventCast, Source: f.Unit.ID, Target: t.Unit.ID, Effect: a.Name})
+
+	e.After(a.CastTime, func() {
+		if f.castGen != gen || !f.alive {
+			return
+		}
+		f.casting = false
+		f.castGen++
+		e.adjustAttackTimer(f)
+		e.resolveCast(f, t, a)
+		e.fireHooks(f, TriggerCast)
+		e.scheduleAct(f, 0)
+	})
+}
+
+// resolveCast picks the spell's targets and deals each damage instance.
+func (e *Engine) resolveCast(f, current *Fighter, a abilities.Ability) {
+	targets := e.castTargets(f, current, a)
+	ctx := abilities.CastContext{Star: f.star, Caster: f.stats}
+	for _, t := range targets {
+		ctx.Targets = append(ctx.Targets, abilities.TargetState{HP: t.hp, MaxHP: t.maxHP})
+	}
+	c, err := abilities.ResolveContext(a, ctx)
+	if err != nil {
+		return // validated at load; a bad ability simply fizzles
+	}
+	for _, inst := range c.Instances {
+		if inst.Target < len(targets) {
+			e.DealDamage(f, targets[inst.Target], Damage{Type: inst.Type, Amount: inst.Amount, Source: SourceAbility})
+		}
+	}
+	if a.Summon != nil {
+		e.castSummon(f, *a.Summon)
+	}
+}
+
+// castTargets resolves a.Targeting to up to a.Targets living, targetable enemies.
+// Ties break on insertion order. Self-targeted spells hit no enemy.
+func (e *Engine) castTargets(f, current *Fighter, a abilities.Ability) []*Fighter {
+	if a.Targeting == abilities.TargetSelf {
+		return nil
+	}
+	var enemies []*Fighter
+	for _, o := range e.fighters {
+		if o.alive && o.Team != f.Team && o.targetable() {
+			enemies = append(enemies, o)
+		}
+	}
+	byDist := func(x, y *Fighter) int { return cmp.Compare(f.Pos.Distance(x.Pos), f.Pos.Distance(y.Pos)) }
+	switch a.Targeting {
+	case abilities.TargetFarthest:
+		slices.SortStableFunc(enemies, func(x, y *Fighter) int { return byDist(y, x) })
+	case abilities.TargetLowestHP:
+		slices.SortStableFunc(enemies, func(x, y *Fighter) int { return cmp.Compare(x.hp, y.hp) })
+	default: // nearest; current_target puts the attack target first
+		slices.SortStableFunc(enemies, byDist)
+		if a.Targeting == abilities.TargetCurrent && current != nil && current.alive {
+			if i := slices.Index(enemies, current); i > 0 {
+				enemies = append([]*Fighter{current}, slices.Delete(enemies, i, i+1)...)
+			}
+		}
+	}
+	return enemies[:min(len(enemies), max(1, a.Targets))]
+}
+
+// adjustAttackTimer applies Config.Timing.CastTimer when f's cast ends (or is
+// interrupted): the time spent casting either delays the attack timer or the
+// next attack is available immediately.
+func (e *Engine) adjustAttackTimer(f *Fighter) {
+	if e.cfg.Timing.CastTimer == CastReset {
+		f.attackReadyAt = e.now
+		return
+	}
+	if f.attackReadyAt > f.castStart {
+		f.attackReadyAt += e.now - f.castStart
+	}
+}
@@ -0,0 +1,67 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func testAbility(castTime float64) abilities.Ability {
+	return abilities.Ability{
+		Name: "Bolt", DamageType: units.True, Base: []float64{100, 150, 200},
+		CastTime: castTime, Targeting: abilities.TargetCurrent, Targets: 1,
+	}
+}
+
+// caster returns an engine with a melee caster next to a passive dummy.
+func caster(t *testing.T, castTime float64, opts ...units.Option) (*Engine, *Fighter, *Fighter) {
+	t.Helper()
+	e := New(Config{MaxTime: 10})
+	c := e.Add(testUnit(t, "caster", opts...), 0, Hex{0, 0}, WithAbility(testAbility(castTime), 2))
+	d := e.Add(dummy(t, "dummy"), 1, Hex{0, 1})
+	return e, c, d
+}
+
+func TestCast_AtFullManaAfterCastTime(t *testing.T) {
+	t.Parallel()
+	e, c, d := caster(t, 0.5, units.WithMana(0, 30, 30, 0, 0))
+	e.RunUntil(1)
+	if got := eventTimes(e.Log(), EventCast, c); !sameTimes(got, []float64{0}) {
+		t.Fatalf("expected one cast at t=0, got %v", got)
+	}
+	if !near(d.HP(), 10000-150) {
+		t.Fatalf("2★ spell should deal 150 true damage, HP=%v", d.HP())
+	}
+	if c.Mana() != 0 {
+		t.Fatalf("casting spends mana, got %v", c.Mana())
+	}
+}
+
+func TestCast_ManaFromAttacks(t *testing.T) {
+	t.Parallel()
+	e, c, _ := caster(t, 0, units.WithAS(1), units.WithAD(10), units.WithMana(0, 20, 0, 0, 10))
+	e.RunUntil(1.9)
+	if got := eventTimes(e.Log(), EventAttack, c); !sameTimes(got, []float64{0, 1}) {
+		t.Fatalf("attacks: %v", got)
+	}
+	e.RunUntil(2.5)
+	if got := eventTimes(e.Log(), EventCast, c); !sameTimes(got, []float64{1}) {
+		t.Fatalf("the second hit fills 20 mana: expected a cast right after it at 1, got %v", got)
+	}
+}
+
+func TestCast_TargetingLowestHP(t *testing.T) {
+	t.Parallel()
+	e := New(Config{})
+	a := testAbility(0)
+	a.Targeting, a.Targets = abilities.TargetLowestHP, 2
+	c := e.Add(testUnit(t, "caster"), 0, Hex{0, 0}, WithAbility(a, 1))
+	e.Add(dummy(t, "full"), 1, Hex{0, 1})
+	low := e.Add(testUnit(t, "low", units.WithHP(500)), 1, Hex{0, 3})
+	mid := e.Add(testUnit(t, "mid", units.WithHP(800)), 1, Hex{0, 4})
+	got := e.castTargets(c, nil, a)
+	if len(got) != 2 || got[0] != low || got[1] != mid {
+		t.Fatalf("expected [low mid], got %d targets", len(got))
+	}
+}
@@ -0,0 +1,103 @@
+package sim
+
+// CC is a crowd-control kind carried by an Effect.
+type CC string
+
+const (
+	// CCStun blocks moving, attacking and casting, pauses the attack timer and
+	// interrupts a cast in progress.
+	CCStun CC = "stun"
+	// CCKnockUp behaves like a stun but ignores tenacity.
+	CCKnockUp CC = "knock_up"
+	// CCSilence blocks starting a cast; a cast already in progress still lands.
+	// Mana keeps accumulating.
+	CCSilence CC = "silence"
+	// CCDisarm blocks auto attacks; moving and casting are still allowed.
+	CCDisarm CC = "disarm"
+)
+
+// Built-in CC effect names.
+const (
+	EffectStun       = "Stun"
+	EffectKnockUp    = "Knock-up"
+	EffectSilence    = "Silence"
+	EffectDisarm     = "Disarm"
+	EffectCCImmunity = "CC Immunity"
+)
+
+func ccEffect(name string, kind CC, duration float64) Effect {
+	return Effect{Name: name, Duration: duration, Stacking: StackRefresh, CC: kind}
+}
+
+// Stun returns a stun lasting duration seconds (before tenacity).
+func Stun(duration float64) Effect { return ccEffect(EffectStun, CCStun, duration) }
+
+// KnockUp returns a knock-up lasting duration seconds; tenacity does not shorten it.
+func KnockUp(duration float64) Effect { return ccEffect(EffectKnockUp, CCKnockUp, duration) }
+
+// Silence returns a silence lasting duration seconds (before tenacity).
+func Silence(duration float64) Effect { return ccEffect(EffectSilence, CCSilence, duration) }
+
+// Disarm returns a disarm lasting duration seconds (before tenacity).
+func Disarm(duration float64) Effect { return ccEffect(EffectDisarm, CCDisarm, duration) }
+
+// CCImmunity makes the target ignore every CC effect for duration seconds.
+func CCImmunity(duration float64) Effect {
+	return Effect{Name: EffectCCImmunity, Duration: duration, Stacking: StackRefresh, CCImmune: true}
+}
+
+// hasCC reports whether an active effect applies the given CC kind.
+func (f *Fighter) hasCC(kind CC) bool {
+	for _, inst := range f.effects {
+		if inst.CC == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// hardCC reports whether f is stunned or knocked up.
+func (f *Fighter) hardCC() bool { return f.hasCC(CCStun) || f.hasCC(CCKnockUp) }
+
+func (f *Fighter) ccImmune() bool {
+	for _, inst := range f.effects {
+		if inst.CCImmune {
+			return true
+		}
+	}
+	return false
+}
+
+// ccChanged reacts to f entering or leaving hard CC.
+//
+// Entering: a cast in progress is interrupted and its mana refunded (the
+// fighter recasts once free), an attack still in its windup is cancelled, and
+// the time left on the attack timer is saved. Leaving: the action loop resumes
+// with that time left, and Config.CCImmunityAfter grants an immunity window.
+func (e *Engine) ccChanged(f *Fighter, wasHard bool) {
+	isHard := f.hardCC()
+	switch {
+	case !wasHard && isHard:
+		f.actGen++    // cancel the pending act
+		f.attackGen++ // and an attack in its windup
+		if f.casting {
+			f.casting = false
+			f.castGen++
+			f.mana = f.stats.Resource.ManaMax
+			e.adjustAttackTimer(f)
+			e.emit(Event{Kind: EventCastInterrupted, Source: f.Unit.ID, Effect: f.ability.Name})
+		}
+		f.timerLeft = max(0, f.attackReadyAt-e.now)
+		if e.now < f.windupEnd {
+			f.timerLeft = 0 // the cancelled attack restarts from scratch
+		}
+	case wasHard && !isHard && f.alive:
+		f.attackReadyAt = e.now + f.timerLeft
+		if e.started {
+			e.scheduleAct(f, 0)
+		}
+		if e.cfg.CCImmunityAfter > 0 {
+			e.ApplyEffect(nil, f, CCImmunity(e.cfg.CCImmunityAfter))
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestCC_StunPausesAttackTimer(t *testing.T) {
+	t.Parallel()
+	e, c, d := caster(t, 0, units.WithAS(0.5), units.WithAD(10))
+	e.RunUntil(0.5)
+	e.ApplyEffect(d, c, Stun(1))
+	e.RunUntil(4.9)
+	// attack at 0, 1.5s left on the timer when stunned at 0.5 → resumes at 1.5+1.5
+	if got := eventTimes(e.Log(), EventAttack, c); !sameTimes(got, []float64{0, 3}) {
+		t.Fatalf("attacks: %v", got)
+	}
+}
+
+func TestCC_StunInterruptsCast(t *testing.T) {
+	t.Parallel()
+	e, c, d := caster(t, 1, units.WithMana(0, 30, 30, 0, 0))
+	e.RunUntil(0.5)
+	e.ApplyEffect(d, c, Stun(1))
+	e.RunUntil(3)
+	if countEvents(e.Log(), EventCastInterrupted, "") != 1 {
+		t.Fatalf("stun during a cast must interrupt it")
+	}
+	// mana refunded → recast when the stun ends at 1.5, lands at 2.5
+	if got := eventTimes(e.Log(), EventCast, c); !sameTimes(got, []float64{0, 1.5}) {
+		t.Fatalf("casts: %v", got)
+	}
+	if !near(d.HP(), 10000-150) {
+		t.Fatalf("only the second cast lands, HP=%v", d.HP())
+	}
+}
+
+func TestCC_SilenceBlocksNewCastsOnly(t *testing.T) {
+	t.Parallel()
+	e, c, d := caster(t, 1, units.WithMana(0, 30, 30, 0, 0))
+	e.RunUntil(0.5)
+	e.ApplyEffect(d, c, Silence(2))
+	e.RunUntil(1.1)
+	if countEvents(e.Log(), EventCastInterrupted, "") != 0 || !near(d.HP(), 10000-150) {
+		t.Fatalf("silence must not interrupt a cast in progress")
+	}
+
+	e2, c2, d2 := caster(t, 0, units.WithAS(1), units.WithMana(0, 30, 30, 0, 0))
+	e2.ApplyEffect(d2, c2, Silence(1.5))
+	e2.RunUntil(2.5)
+	if got := eventTimes(e2.Log(), EventCast, c2); !sameTimes(got, []float64{2}) {
+		t.Fatalf("silenced caster attacks and casts after the silence: %v", got)
+	}
+}
+
+func TestCC_DisarmBlocksAttacks(t *testing.T) {
+	t.Parallel()
+	e, c, d := caster(t, 0, units.WithAS(1), units.WithAD(10))
+	e.ApplyEffect(d, c, Disarm(2))
+	e.RunUntil(2.9)
+	if got := eventTimes(e.Log(), EventAttack, c); len(got) != 1 || got[0] < 2 {
+		t.Fatalf("no attack before the disarm ends: %v", got)
+	}
+}
+
+func TestCC_TenacityAndKnockUp(t *testing.T) {
+	t.Parallel()
+	e, src, _ := effectBoard(t)
+	tough := e.Add(dummy(t, "tough", units.WithTenacity(0.5)), 1, Hex{2, 2})
+	e.ApplyEffect(src, tough, Stun(2))
+	e.RunUntil(1.01)
+	if tough.HasEffect(EffectStun) {
+		t.Fatalf("50%% tenacity halves a 2s stun")
+	}
+	e.ApplyEffect(src, tough, KnockUp(2))
+	e.RunUntil(2.9)
+	if !tough.HasEffect(EffectKnockUp) {
+		t.Fatalf("knock-up ignores tenacity")
+	}
+}
+
+func TestCC_OnlyExtends(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, Stun(2))
+	e.RunUntil(1)
+	e.ApplyEffect(src, dst, Stun(0.5))
+	e.RunUntil(1.9)
+	if !dst.HasEffect(EffectStun) || countEvents(e.Log(), EventEffectRejected, EffectStun) != 1 {
+		t.Fatalf("a shorter stun must not cut an active one")
+	}
+}
+
+func TestCC_Immunity(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, CCImmunity(1))
+	e.ApplyEffect(src, dst, Stun(2))
+	if dst.HasEffect(EffectStun) {
+		t.Fatalf("CC immunity must reject stuns")
+	}
+
+	e2 := New(Config{CCImmunityAfter: 2})
+	a := e2.Add(testUnit(t, "a"), 0, Hex{0, 0})
+	b := e2.Add(dummy(t, "b"), 1, Hex{0, 5})
+	e2.ApplyEffect(a, b, Stun(1))
+	e2.RunUntil(1.5)
+	e2.ApplyEffect(a, b, Stun(1))
+	if b.HasEffect(EffectStun) || !b.HasEffect(EffectCCImmunity) {
+		t.Fatalf("Config.CCImmunityAfter should protect after a stun ends")
+	}
+}
+
+func TestCC_ChanceIsSeeded(t *testing.T) {
+	t.Parallel()
+	run := func(seed uint64) []bool {
+		e := New(Config{Seed: seed})
+		a := e.Add(testUnit(t, "a"), 0, Hex{0, 0})
+		b := e.Add(dummy(t, "b"), 1, Hex{0, 5})
+		var hits []bool
+		for i := 0; i < 20; i++ {
+			e.RemoveEffect(b, EffectStun)
+			eff := Stun(1)
+			eff.Chance = 0.5
+			e.ApplyEffect(a, b, eff)
+			hits = append(hits, b.HasEffect(EffectStun))
+		}
+		return hits
+	}
+	a, b := run(9), run(9)
+	landed := 0
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed must give the same rolls")
+		}
+		if a[i] {
+			landed++
+		}
+	}
+	if landed == 0 || landed == len(a) {
+		t.Fatalf("a 50%% chance should both land and miss over 20 rolls, landed %d", landed)
+	}
+}
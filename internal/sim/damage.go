@@ -0,0 +1,92 @@
+package sim
+
+import (
+	"math"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Damage is one pre-mitigation damage instance.
+type Damage struct {
+	Type   units.DamageType
+	Amount float64
+	Source SourceKind
+	Label  string // breakdown attribution (item, trait, effect name); "" → Source
+	Crit   bool
+}
+
+// label is the breakdown key for d.
+func (d Damage) label() string {
+	if d.Label != "" {
+		return d.Label
+	}
+	return string(d.Source)
+}
+
+// DamageResult reports how an instance resolved against its target.
+type DamageResult struct {
+	PreMitigation  float64
+	PostMitigation float64 // after resistances and durability
+	Absorbed       float64 // part of PostMitigation taken by shields
+	HPDamage       float64 // part of PostMitigation taken from HP
+	Killed         bool
+}
+
+// mitigate applies the target's resistances and durability.
+// Physical uses Armor, magic uses MR: amount × 100 / (100 + resist).
+func mitigate(amount float64, typ units.DamageType, def units.DefenseStats) float64 {
+	switch typ {
+	case units.Physical:
+		amount *= 100 / (100 + math.Max(0, def.Armor))
+	case units.Magic:
+		amount *= 100 / (100 + math.Max(0, def.MR))
+	}
+	return amount * (1 - math.Min(1, math.Max(0, def.Durability)))
+}
+
+// DealDamage runs the damage pipeline from src (may be nil) to dst and logs it:
+// mitigation, then shields, then HP, then the source's omnivamp. A summon's
+// damage is credited to its owner.
+func (e *Engine) DealDamage(src, dst *Fighter, d Damage) DamageResult {
+	res := DamageResult{PreMitigation: d.Amount}
+	if dst == nil || !dst.alive || d.Amount <= 0 {
+		return res
+	}
+	post := mitigate(d.Amount, d.Type, dst.stats.Defense)
+	res.PostMitigation = post
+	res.Absorbed = e.absorb(dst, post, d.Type)
+	res.HPDamage = math.Min(post-res.Absorbed, dst.hp)
+
+	e.manaFromDamage(dst, d.Amount, post)
+	dst.hp -= res.HPDamage
+	dst.tally.DamageTaken += res.HPDamage
+	dst.tally.ShieldAbsorbed += res.Absorbed
+	if src != nil {
+		credit, key, label := src, BreakdownKey{Source: d.Source, Label: d.Label, Type: d.Type}, d.label()
+		if src.owner != nil {
+			credit, key, label = src.owner, BreakdownKey{Source: SourceSummon, Label: src.Unit.Name, Type: d.Type}, src.Unit.Name
+		}
+		credit.tally.DamageDealt += res.Absorbed + res.HPDamage
+		if credit.tally.DamageBySource == nil {
+			credit.tally.DamageBySource = map[string]float64{}
+		}
+		credit.tally.DamageBySource[label] += res.Absorbed + res.HPDamage
+		if credit.dealt == nil {
+			credit.dealt = map[BreakdownKey]float64{}
+		}
+		credit.dealt[key] += res.Absorbed + res.HPDamage
+	}
+	ev := Event{Kind: EventDamage, Source: fighterID(src), Target: dst.Unit.ID, Amount: res.HPDamage, Absorbed: res.Absorbed,
+		DamageType: d.Type, SourceKind: d.Source, Label: d.Label, Crit: d.Crit}
+	e.emit(ev)
+
+	if dst.hp <= 0 {
+		dst.hp = 0
+		dst.alive = false
+		dst.diedAt = e.now
+		res.Killed = true
+		e.emit(Event{Kind: EventDeath, Source: ev.Source, Target: dst.Unit.ID})
+	}
+	e.omnivamp(src, res.Absorbed+res.HPDamage)
+	return res
+}
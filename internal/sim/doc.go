@@ -0,0 +1,8 @@
+// Package sim is the combat runtime: fighters built from units.Unit placed on a
+// hex board, a deterministic event timeline, the damage pipeline and runtime
+// status effects.
+//
+// Determinism: all randomness comes from the engine's seeded RNG and events at
+// the same timestamp run in scheduling order, so a (board, seed) pair always
+// produces the same event log.
+package sim
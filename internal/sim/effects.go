@@ -0,0 +1,249 @@
+package sim
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// Stacking decides what happens when an effect is applied to a fighter that
+// already has an effect with the same name.
+type Stacking string
+
+const (
+	// StackRefresh keeps a single instance and resets its duration.
+	StackRefresh Stacking = "refresh"
+	// StackAdd adds a stack (up to MaxStacks) and resets the duration.
+	StackAdd Stacking = "stack_add"
+	// StackHighest keeps only the strongest instance (by Potency); an equal one
+	// refreshes it, a weaker one is rejected.
+	StackHighest Stacking = "highest_wins"
+	// StackUniquePerSource keeps one instance per source; re-applying from the
+	// same source refreshes it, other sources coexist.
+	StackUniquePerSource Stacking = "unique_per_source"
+)
+
+// Modifier changes one stat (units stat path, e.g. "defense.armor") per stack.
+// Effective value = (base + ΣAdd) × (1 + ΣMult), clamped by stat sanitization.
+type Modifier struct {
+	Path string
+	Add  float64 // flat amount per stack
+	Mult float64 // fraction of the (base + flat) value per stack: -0.3 = −30%
+}
+
+// TickContext is passed to an effect's OnTick callback.
+type TickContext struct {
+	Engine *Engine
+	Source *Fighter // may be nil (environment)
+	Target *Fighter
+	Stacks int
+}
+
+// Effect is a status-effect definition. The same value can be applied many times.
+type Effect struct {
+	Name      string
+	Duration  float64 // seconds; <= 0 lasts until combat ends
+	Stacking  Stacking
+	MaxStacks int     // StackAdd cap (<= 0 → 1)
+	Potency   float64 // StackHighest comparison key
+	Modifiers []Modifier
+
+	// HealingReduction is the fraction of incoming healing removed (Wound).
+	HealingReduction float64
+
+	TickEvery float64 // seconds between OnTick calls (<= 0 → no ticks)
+	OnTick    func(TickContext)
+
+	CC       CC      // crowd control applied while active ("" → none)
+	CCImmune bool    // while active, CC effects are rejected
+	Chance   float64 // application chance rolled on the engine RNG (<= 0 or >= 1 → always)
+}
+
+// effectInstance is an effect active on one fighter.
+type effectInstance struct {
+	Effect
+	source    *Fighter
+	stacks    int
+	expiresAt float64 // +Inf when permanent
+	gen       uint64  // bumped on refresh/removal; stale expiry timers compare against it
+	nextTick  float64
+	removed   bool
+}
+
+// ApplyEffect applies eff from src (may be nil) to dst following eff.Stacking.
+// Application, refresh and expiry are timeline events in the combat log.
+func (e *Engine) ApplyEffect(src, dst *Fighter, eff Effect) {
+	if dst == nil || !dst.alive {
+		return
+	}
+	if eff.Stacking == "" {
+		eff.Stacking = StackRefresh
+	}
+	if eff.MaxStacks <= 0 {
+		eff.MaxStacks = 1
+	}
+	reject := func() {
+		e.emit(Event{Kind: EventEffectRejected, Source: fighterID(src), Target: dst.Unit.ID, Effect: eff.Name})
+	}
+	if eff.Chance > 0 && eff.Chance < 1 && e.rng.Float64() >= eff.Chance {
+		return // missed roll: nothing happened, nothing logged
+	}
+	if eff.CC != "" {
+		if dst.ccImmune() {
+			reject()
+			return
+		}
+		if eff.CC != CCKnockUp {
+			eff.Duration *= 1 - dst.stats.Defense.Tenacity
+		}
+		if eff.Duration <= 0 {
+			reject() // fully resisted (a CC never becomes permanent)
+			return
+		}
+	}
+
+	inst := dst.findEffect(eff.Name, src, eff.Stacking)
+	isNew := inst == nil
+	wasHard := dst.hardCC()
+	switch {
+	case !isNew && eff.CC != "" && inst.expiresAt >= e.now+eff.Duration:
+		reject() // CC only ever extends: a shorter re-application changes nothing
+		return
+	case isNew:
+		inst = &effectInstance{Effect: eff, source: src, stacks: 1}
+		dst.effects = append(dst.effects, inst)
+	case eff.Stacking == StackAdd:
+		inst.stacks = min(inst.stacks+1, eff.MaxStacks)
+	case eff.Stacking == StackHighest && eff.Potency < inst.Potency:
+		reject()
+		return
+	case eff.Stacking == StackHighest && eff.Potency > inst.Potency:
+		inst.Effect, inst.source = eff, src // stronger replaces weaker
+	}
+
+	inst.gen++
+	inst.expiresAt = math.Inf(1)
+	if eff.Duration > 0 {
+		inst.expiresAt = e.now + eff.Duration
+		gen := inst.gen
+		e.After(eff.Duration, func() {
+			if inst.gen != gen {
+				return
+			}
+			// A tick due at the expiry instant still lands (a 3s Burn ticks 3 times).
+			if inst.OnTick != nil && inst.TickEvery > 0 && inst.nextTick <= e.now+tickEpsilon {
+				e.tick(dst, inst)
+			}
+			e.removeEffect(dst, inst)
+		})
+	}
+	// Ticks keep their cadence across refreshes: only a new instance starts the chain.
+	if isNew && eff.TickEvery > 0 && eff.OnTick != nil {
+		e.scheduleTick(dst, inst)
+	}
+
+	dst.recomputeStats()
+	e.emit(Event{Kind: EventEffectApplied, Source: fighterID(src), Target: dst.Unit.ID, Effect: eff.Name, Stacks: inst.stacks})
+	e.ccChanged(dst, wasHard)
+}
+
+// tickEpsilon absorbs float drift when comparing tick and expiry times.
+const tickEpsilon = 1e-9
+
+// scheduleTick runs OnTick every TickEvery until the instance is removed.
+func (e *Engine) scheduleTick(dst *Fighter, inst *effectInstance) {
+	inst.nextTick = e.now + inst.TickEvery
+	e.After(inst.TickEvery, func() {
+		if inst.removed {
+			return
+		}
+		e.tick(dst, inst)
+		e.scheduleTick(dst, inst)
+	})
+}
+
+func (e *Engine) tick(dst *Fighter, inst *effectInstance) {
+	if !dst.alive || inst.OnTick == nil {
+		return
+	}
+	e.emit(Event{Kind: EventEffectTick, Source: fighterID(inst.source), Target: dst.Unit.ID, Effect: inst.Name, Stacks: inst.stacks})
+	inst.OnTick(TickContext{Engine: e, Source: inst.source, Target: dst, Stacks: inst.stacks})
+}
+
+// RemoveEffect removes every instance of the named effect from f (cleanse).
+func (e *Engine) RemoveEffect(f *Fighter, name string) {
+	for _, inst := range append([]*effectInstance(nil), f.effects...) {
+		if inst.Name == name {
+			e.removeEffect(f, inst)
+		}
+	}
+}
+
+func (e *Engine) removeEffect(f *Fighter, inst *effectInstance) {
+	wasHard := f.hardCC()
+	for i, cur := range f.effects {
+		if cur == inst {
+			f.effects = append(f.effects[:i], f.effects[i+1:]...)
+			break
+		}
+	}
+	inst.gen++
+	inst.removed = true
+	f.recomputeStats()
+	e.emit(Event{Kind: EventEffectExpired, Source: fighterID(inst.source), Target: f.Unit.ID, Effect: inst.Name, Stacks: inst.stacks})
+	e.ccChanged(f, wasHard)
+}
+
+func (f *Fighter) findEffect(name string, src *Fighter, st Stacking) *effectInstance {
+	for _, inst := range f.effects {
+		if inst.Name != name {
+			continue
+		}
+		if st == StackUniquePerSource && inst.source != src {
+			continue
+		}
+		return inst
+	}
+	return nil
+}
+
+// recomputeStats rebuilds effective stats from the unit's base stats.
+// Max HP is fixed at combat start; HP modifiers do not heal or hurt.
+func (f *Fighter) recomputeStats() {
+	base := f.Unit.Stats
+	type acc struct{ add, mult float64 }
+	sums := map[string]*acc{}
+	var order []string
+	for _, inst := range f.effects {
+		for _, m := range inst.Modifiers {
+			a, ok := sums[m.Path]
+			if !ok {
+				a = &acc{}
+				sums[m.Path] = a
+				order = append(order, m.Path)
+			}
+			a.add += m.Add * float64(inst.stacks)
+			a.mult += m.Mult * float64(inst.stacks)
+		}
+	}
+	s := base
+	for _, p := range order {
+		v, ok := base.Value(p)
+		if !ok {
+			continue
+		}
+		a := sums[p]
+		s.SetValue(p, (v+a.add)*math.Max(0, 1+a.mult))
+	}
+	if ns, err := s.With(); err == nil {
+		s = ns // sanitized (e.g. negative armor → 0)
+	}
+	f.stats = s
+}
+
+func fighterID(f *Fighter) uuid.UUID {
+	if f == nil {
+		return uuid.Nil
+	}
+	return f.Unit.ID
+}
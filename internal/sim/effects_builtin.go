@@ -0,0 +1,100 @@
+package sim
+
+import "github.com/0xm0-v1/simfight-tactics/internal/models/units"
+
+// Built-in effect names.
+const (
+	EffectShred   = "Shred"
+	EffectSunder  = "Sunder"
+	EffectBurn    = "Burn"
+	EffectWound   = "Wound"
+	EffectChill   = "Chill"
+	EffectASRamp  = "AttackSpeedRamp"
+	EffectRegen   = "Regen"
+	burnTickEvery = 1.0
+)
+
+// Standard TFT magnitudes for the built-ins.
+const (
+	DefaultShred  = 0.30 // −30% MR
+	DefaultSunder = 0.30 // −30% Armor
+	DefaultBurn   = 0.01 // 1% max HP true damage per second
+	DefaultWound  = 0.33 // −33% healing received
+	DefaultChill  = 0.20 // −20% attack speed
+)
+
+// Shred reduces magic resist by pct; the strongest Shred wins.
+func Shred(pct, duration float64) Effect {
+	return Effect{
+		Name: EffectShred, Duration: duration, Stacking: StackHighest, Potency: pct,
+		Modifiers: []Modifier{{Path: "defense.magic_resist", Mult: -pct}},
+	}
+}
+
+// Sunder reduces armor by pct; the strongest Sunder wins.
+func Sunder(pct, duration float64) Effect {
+	return Effect{
+		Name: EffectSunder, Duration: duration, Stacking: StackHighest, Potency: pct,
+		Modifiers: []Modifier{{Path: "defense.armor", Mult: -pct}},
+	}
+}
+
+// Chill reduces attack speed by pct; the strongest Chill wins.
+func Chill(pct, duration float64) Effect {
+	return Effect{
+		Name: EffectChill, Duration: duration, Stacking: StackHighest, Potency: pct,
+		Modifiers: []Modifier{{Path: "offense.attack_speed", Mult: -pct}},
+	}
+}
+
+// Wound reduces healing received by pct; the strongest Wound wins.
+func Wound(pct, duration float64) Effect {
+	return Effect{
+		Name: EffectWound, Duration: duration, Stacking: StackHighest, Potency: pct,
+		HealingReduction: pct,
+	}
+}
+
+// Burn deals pctMaxHP of the target's max HP as true damage every second.
+// Re-applying refreshes the duration; the strongest Burn wins.
+func Burn(pctMaxHP, duration float64) Effect {
+	return Effect{
+		Name: EffectBurn, Duration: duration, Stacking: StackHighest, Potency: pctMaxHP,
+		TickEvery: burnTickEvery,
+		OnTick: func(c TickContext) {
+			c.Engine.DealDamage(c.Source, c.Target, Damage{
+				Type:   units.True,
+				Amount: pctMaxHP * c.Target.MaxHP(),
+				Source: SourceBurn,
+				Label:  EffectBurn,
+			})
+		},
+	}
+}
+
+// Regen heals perSecond HP every second for duration seconds (0 → rest of
+// combat). Regen effects with different names stack.
+func Regen(name string, perSecond, duration float64) Effect {
+	if name == "" {
+		name = EffectRegen
+	}
+	return Effect{
+		Name: name, Duration: duration, Stacking: StackRefresh,
+		TickEvery: 1,
+		OnTick: func(c TickContext) {
+			c.Engine.Heal(c.Source, c.Target, perSecond, name)
+		},
+	}
+}
+
+// AttackSpeedRamp grants perStack attack speed (fraction of base) per stack,
+// up to maxStacks, for the rest of combat (e.g. applied on every attack).
+func AttackSpeedRamp(name string, perStack float64, maxStacks int) Effect {
+	if name == "" {
+		name = EffectASRamp
+	}
+	return Effect{
+		Name: name, Stacking: StackAdd, MaxStacks: maxStacks,
+		Modifiers: []Modifier{{Path: "offense.attack_speed", Mult: perStack}},
+	}
+}
@@ -0,0 +1,129 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// effectBoard returns an engine with a passive source and target (no attacks).
+func effectBoard(t *testing.T) (*Engine, *Fighter, *Fighter) {
+	t.Helper()
+	e := New(Config{MaxTime: 60})
+	src := e.Add(testUnit(t, "src"), 0, Hex{0, 0})
+	dst := e.Add(dummy(t, "dst", units.WithArmor(100), units.WithMR(100), units.WithAS(1)), 1, Hex{0, 5})
+	return e, src, dst
+}
+
+func TestEffects_ModifiersApplyAndExpire(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, Sunder(DefaultSunder, 4))
+	e.ApplyEffect(src, dst, Shred(DefaultShred, 2))
+	if !near(dst.Stats().Defense.Armor, 70) || !near(dst.Stats().Defense.MR, 70) {
+		t.Fatalf("expected 70 armor/MR, got %+v", dst.Stats().Defense)
+	}
+	e.RunUntil(3)
+	if !near(dst.Stats().Defense.MR, 100) || !near(dst.Stats().Defense.Armor, 70) {
+		t.Fatalf("Shred should expire at 2s, Sunder persist: %+v", dst.Stats().Defense)
+	}
+	e.RunUntil(5)
+	if !near(dst.Stats().Defense.Armor, 100) || dst.HasEffect(EffectSunder) {
+		t.Fatalf("Sunder should expire at 4s")
+	}
+	if countEvents(e.Log(), EventEffectApplied, "") != 2 || countEvents(e.Log(), EventEffectExpired, "") != 2 {
+		t.Fatalf("apply/expire must be logged")
+	}
+}
+
+func TestEffects_Refresh_ExtendsDuration(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	eff := Effect{Name: "Slow", Duration: 2, Stacking: StackRefresh,
+		Modifiers: []Modifier{{Path: "offense.attack_speed", Mult: -0.5}}}
+	e.ApplyEffect(src, dst, eff)
+	e.RunUntil(1.5)
+	e.ApplyEffect(src, dst, eff)
+	e.RunUntil(3)
+	if !dst.HasEffect("Slow") || dst.Stacks("Slow") != 1 {
+		t.Fatalf("refresh should keep one instance alive until 3.5s")
+	}
+	e.RunUntil(3.6)
+	if dst.HasEffect("Slow") {
+		t.Fatalf("refreshed effect should expire at 3.5s")
+	}
+	if countEvents(e.Log(), EventEffectExpired, "Slow") != 1 {
+		t.Fatalf("stale expiry timer must not fire")
+	}
+}
+
+func TestEffects_StackAdd_CapsAndScales(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	ramp := AttackSpeedRamp("", 0.1, 3)
+	for i := 0; i < 5; i++ {
+		e.ApplyEffect(src, dst, ramp)
+	}
+	if dst.Stacks(EffectASRamp) != 3 || !near(dst.Stats().Offense.AS, 1.3) {
+		t.Fatalf("expected 3 stacks and 1.3 AS, got %d / %v", dst.Stacks(EffectASRamp), dst.Stats().Offense.AS)
+	}
+	e.ApplyEffect(src, dst, Chill(DefaultChill, 5))
+	if !near(dst.Stats().Offense.AS, 1.1) {
+		t.Fatalf("ramp and chill add up: want 1.1, got %v", dst.Stats().Offense.AS)
+	}
+}
+
+func TestEffects_HighestWins(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, Shred(0.2, 5))
+	e.ApplyEffect(src, dst, Shred(0.1, 5)) // weaker → rejected
+	if !near(dst.Stats().Defense.MR, 80) || countEvents(e.Log(), EventEffectRejected, EffectShred) != 1 {
+		t.Fatalf("weaker shred must be rejected, MR=%v", dst.Stats().Defense.MR)
+	}
+	e.ApplyEffect(src, dst, Shred(0.4, 5)) // stronger → replaces
+	if !near(dst.Stats().Defense.MR, 60) || dst.Stacks(EffectShred) != 1 {
+		t.Fatalf("stronger shred must replace, MR=%v", dst.Stats().Defense.MR)
+	}
+}
+
+func TestEffects_UniquePerSource(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	other := e.Add(testUnit(t, "other"), 0, Hex{1, 0})
+	mark := Effect{Name: "Mark", Duration: 5, Stacking: StackUniquePerSource,
+		Modifiers: []Modifier{{Path: "defense.armor", Add: -10}}}
+	e.ApplyEffect(src, dst, mark)
+	e.ApplyEffect(src, dst, mark)
+	e.ApplyEffect(other, dst, mark)
+	if dst.Stacks("Mark") != 2 || !near(dst.Stats().Defense.Armor, 80) {
+		t.Fatalf("one instance per source: stacks=%d armor=%v", dst.Stacks("Mark"), dst.Stats().Defense.Armor)
+	}
+}
+
+func TestEffects_BurnTicksTrueDamage(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, Burn(DefaultBurn, 3))
+	e.RunUntil(10)
+	if n := countEvents(e.Log(), EventEffectTick, EffectBurn); n != 3 {
+		t.Fatalf("3s burn should tick 3 times, got %d", n)
+	}
+	if want := 10000 - 3*100.0; !near(dst.HP(), want) {
+		t.Fatalf("burn is 1%% max HP true damage per tick: HP=%v want %v", dst.HP(), want)
+	}
+}
+
+func TestEffects_BurnRefreshKeepsCadence(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.ApplyEffect(src, dst, Burn(DefaultBurn, 2))
+	for t := 0.9; t < 4; t += 0.9 {
+		e.RunUntil(t)
+		e.ApplyEffect(src, dst, Burn(DefaultBurn, 2))
+	}
+	e.RunUntil(4)
+	if n := countEvents(e.Log(), EventEffectTick, EffectBurn); n != 4 {
+		t.Fatalf("refreshing must not reset the tick clock: got %d ticks by 4s", n)
+	}
+}
@@ -0,0 +1,288 @@
+package sim
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Config tunes an Engine. Zero fields take the defaults below.
+type Config struct {
+	Seed     uint64  // RNG seed (crits, rolls)
+	MaxTime  float64 // combat timeout in seconds (default 30)
+	MoveTime float64 // seconds to move one hex (default 0.5)
+
+	Timing AttackTiming      // windup, projectile travel, AS cap, cast/attack timer interplay
+	Game   units.GameContext // stage of the fight; units should be built for the same stage
+
+	// CCImmunityAfter grants this many seconds of CC immunity when a fighter's
+	// last stun/knock-up ends (0 → none).
+	CCImmunityAfter float64
+}
+
+const (
+	defaultMaxTime  = 30.0
+	defaultMoveTime = 0.5
+)
+
+// Timeout is the effective combat timeout: MaxTime or its default.
+func (c Config) Timeout() float64 {
+	if c.MaxTime <= 0 {
+		return defaultMaxTime
+	}
+	return c.MaxTime
+}
+
+// Engine runs one deterministic combat.
+type Engine struct {
+	cfg      Config
+	now      float64
+	timeline timeline
+	rng      *rand.Rand
+	fighters []*Fighter
+	log      []Event
+	started  bool
+
+	traitBonus [2]map[string]int // per team: augment trait grants (Scenario.Engine)
+	shieldSeq  int
+}
+
+// New returns an engine seeded from cfg.Seed.
+func New(cfg Config) *Engine {
+	cfg.MaxTime = cfg.Timeout()
+	if cfg.MoveTime <= 0 {
+		cfg.MoveTime = defaultMoveTime
+	}
+	cfg.Timing = cfg.Timing.withDefaults()
+	return &Engine{
+		cfg: cfg,
+		rng: rand.New(rand.NewPCG(cfg.Seed, cfg.Seed^0x9e3779b97f4a7c15)),
+	}
+}
+
+// Add places a unit on the board for team at pos.
+func (e *Engine) Add(u units.Unit, team int, pos Hex, opts ...FighterOption) *Fighter {
+	f := newFighter(u, team, pos, len(e.fighters))
+	for _, opt := range opts {
+		opt(f)
+	}
+	e.fighters = append(e.fighters, f)
+	return f
+}
+
+// Now is the current combat time in seconds.
+func (e *Engine) Now() float64 { return e.now }
+
+// Fighters returns every fighter in insertion order (dead ones and summons
+// included).
+func (e *Engine) Fighters() []*Fighter { return e.fighters }
+
+// Log returns the structured event log.
+func (e *Engine) Log() []Event { return e.log }
+
+// Game is the stage the fight takes place at (for stage-aware hooks).
+func (e *Engine) Game() units.GameContext { return e.cfg.Game }
+
+// Rand exposes the engine RNG so hooks stay on the same seeded stream.
+func (e *Engine) Rand() *rand.Rand { return e.rng }
+
+// After schedules fn to run d seconds from now (d < 0 runs now).
+func (e *Engine) After(d float64, fn func()) {
+	e.timeline.schedule(e.now+math.Max(0, d), fn)
+}
+
+func (e *Engine) emit(ev Event) {
+	ev.T = e.now
+	e.log = append(e.log, ev)
+}
+
+// Start schedules every fighter's first action. Run calls it if needed.
+func (e *Engine) Start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	for _, f := range e.fighters {
+		e.fireHooks(f, TriggerStart)
+		e.scheduleEvery(f)
+	}
+	for _, f := range e.fighters {
+		if !f.alive || f.hardCC() {
+			continue // the action loop starts when the CC ends
+		}
+		e.scheduleAct(f, 0)
+		if f.stats.Resource.ManaRegen > 0 && f.ability != nil {
+			e.scheduleRegen(f)
+		}
+	}
+}
+
+// scheduleAct (re)arms f's action loop d seconds from now, cancelling any pending act.
+func (e *Engine) scheduleAct(f *Fighter, d float64) {
+	f.actGen++
+	gen := f.actGen
+	e.After(d, func() {
+		if f.actGen == gen {
+			e.act(f)
+		}
+	})
+}
+
+// Run simulates until one team is wiped, nothing is scheduled, or MaxTime.
+func (e *Engine) Run() {
+	e.RunUntil(e.cfg.MaxTime)
+}
+
+// RunUntil processes events up to time t (capped at MaxTime) or until combat ends.
+func (e *Engine) RunUntil(t float64) {
+	e.Start()
+	t = math.Min(t, e.cfg.MaxTime)
+	for e.timeline.Len() > 0 && !e.Over() {
+		if e.timeline.peekTime() > t {
+			break
+		}
+		a := e.timeline.pop()
+		e.now = a.at
+		a.fn()
+	}
+	if !e.Over() && e.now < t {
+		e.now = t
+	}
+}
+
+// Over reports whether at most one team still has living fighters
+// (untargetable summons do not count).
+func (e *Engine) Over() bool {
+	team := -1
+	for _, f := range e.fighters {
+		if !f.alive || !f.targetable() {
+			continue
+		}
+		if team == -1 {
+			team = f.Team
+		} else if f.Team != team {
+			return false
+		}
+	}
+	return true
+}
+
+// act is a fighter's main loop step: acquire a target, close distance, then
+// cast at full mana or attack. Hard CC pauses the loop (see ccChanged).
+func (e *Engine) act(f *Fighter) {
+	if !f.alive || f.casting || f.hardCC() {
+		return
+	}
+	if f.target == nil || !f.target.alive {
+		f.target = e.acquireTarget(f)
+	}
+	t := f.target
+	if t == nil {
+		return // no enemies left
+	}
+	if float64(f.Pos.Distance(t.Pos)) > f.stats.Offense.Range {
+		e.step(f, t)
+		e.scheduleAct(f, e.cfg.MoveTime)
+		return
+	}
+	if f.canCast() {
+		e.cast(f, t)
+		return
+	}
+	as := f.stats.Offense.AS
+	if as <= 0 || f.hasCC(CCDisarm) {
+		e.scheduleAct(f, e.cfg.MoveTime) // cannot attack; re-check later (buffs may change AS, disarm may end)
+		return
+	}
+	if wait := f.attackReadyAt - e.now; wait > tickEpsilon {
+		e.scheduleAct(f, wait)
+		return
+	}
+	interval := e.cfg.Timing.interval(as)
+	f.attackReadyAt = e.now + interval
+	e.autoAttack(f, t, interval)
+	e.scheduleAct(f, interval)
+}
+
+// acquireTarget picks the nearest living, targetable enemy; ties go to the
+// higher TargetPriority, then to insertion order.
+func (e *Engine) acquireTarget(f *Fighter) *Fighter {
+	var best *Fighter
+	bestDist := 0
+	for _, o := range e.fighters {
+		if !o.alive || o.Team == f.Team || !o.targetable() {
+			continue
+		}
+		d := f.Pos.Distance(o.Pos)
+		switch {
+		case best == nil, d < bestDist:
+			best, bestDist = o, d
+		case d == bestDist && o.stats.Defense.TargetPriority > best.stats.Defense.TargetPriority:
+			best = o
+		}
+	}
+	return best
+}
+
+// step moves f one hex toward t onto the free neighbor closest to t.
+func (e *Engine) step(f, t *Fighter) {
+	bestDist := f.Pos.Distance(t.Pos)
+	var next Hex
+	moved := false
+	for _, n := range f.Pos.Neighbors() {
+		if e.occupied(n) {
+			continue
+		}
+		if d := n.Distance(t.Pos); d < bestDist {
+			next, bestDist, moved = n, d, true
+		}
+	}
+	if !moved {
+		return // blocked: wait for the board to change
+	}
+	f.Pos = next
+	pos := next
+	e.emit(Event{Kind: EventMove, Source: f.Unit.ID, Target: t.Unit.ID, Pos: &pos})
+}
+
+func (e *Engine) occupied(h Hex) bool {
+	for _, o := range e.fighters {
+		if o.alive && o.Pos == h {
+			return true
+		}
+	}
+	return false
+}
+
+// autoAttack starts an attack on t: after the windup the attack is released
+// (crit rolled, attack event logged) and lands after the projectile travel,
+// dealing AD (amped) as physical damage and granting mana on hit.
+func (e *Engine) autoAttack(f, t *Fighter, interval float64) {
+	gen := f.attackGen
+	windup := e.cfg.Timing.Windup * interval
+	f.windupEnd = e.now + windup
+	e.After(windup, func() {
+		if f.attackGen != gen || !f.alive || !t.alive {
+			return // cancelled by hard CC, or nobody left to hit
+		}
+		off := f.stats.Offense
+		crit := e.rng.Float64() < off.CritChance
+		amount := off.AD * (1 + off.DamageAmp)
+		if crit {
+			amount *= off.CritDamage
+		}
+		e.emit(Event{Kind: EventAttack, Source: f.Unit.ID, Target: t.Unit.ID, Crit: crit})
+		e.After(e.cfg.Timing.travel(f, f.Pos.Distance(t.Pos)), func() {
+			if !t.alive {
+				return // target died while the projectile was in flight
+			}
+			e.gainMana(f, f.stats.Resource.ManaPerHit)
+			res := e.DealDamage(f, t, Damage{Type: units.Physical, Amount: amount, Source: SourceAutoAttack, Crit: crit})
+			e.runOnHit(f, t, crit, res)
+			if f.canCast() && !f.hardCC() {
+				e.scheduleAct(f, 0) // cast as soon as the hit fills the mana bar
+			}
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package sim
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func duel(t *testing.T, seed uint64) *Engine {
+	t.Helper()
+	e := New(Config{Seed: seed})
+	e.Add(testUnit(t, "A", units.WithHP(800), units.WithAD(60), units.WithAS(0.8), units.WithCritChance(0.25)), 0, Hex{0, 0})
+	e.Add(testUnit(t, "B", units.WithHP(800), units.WithAD(55), units.WithAS(0.7), units.WithArmor(20)), 1, Hex{0, 3})
+	e.Run()
+	return e
+}
+
+func TestEngine_DuelEndsWithOneSurvivor(t *testing.T) {
+	t.Parallel()
+	e := duel(t, 7)
+	if !e.Over() {
+		t.Fatalf("expected combat to end")
+	}
+	alive := 0
+	for _, f := range e.Fighters() {
+		if f.Alive() {
+			alive++
+		}
+	}
+	if alive != 1 || countEvents(e.Log(), EventDeath, "") != 1 {
+		t.Fatalf("expected exactly one survivor and one death, got alive=%d", alive)
+	}
+	if countEvents(e.Log(), EventMove, "") == 0 {
+		t.Fatalf("melee units 3 hexes apart should move")
+	}
+}
+
+func TestEngine_SameSeedSameLog(t *testing.T) {
+	t.Parallel()
+	a, b := duel(t, 42), duel(t, 42)
+	if !reflect.DeepEqual(a.Log(), b.Log()) {
+		t.Fatalf("same seed must produce identical logs")
+	}
+	differs := false
+	for seed := uint64(1); seed < 10 && !differs; seed++ {
+		differs = !reflect.DeepEqual(a.Log(), duel(t, seed).Log())
+	}
+	if !differs {
+		t.Fatalf("crit rolls should make some seeds diverge")
+	}
+}
+
+func TestEngine_Mitigation(t *testing.T) {
+	t.Parallel()
+	e := New(Config{})
+	src := e.Add(testUnit(t, "src"), 0, Hex{0, 0})
+	dst := e.Add(dummy(t, "dst", units.WithArmor(100), units.WithMR(50), units.WithDurability(0.1)), 1, Hex{0, 1})
+
+	cases := []struct {
+		typ  units.DamageType
+		want float64
+	}{
+		{units.Physical, 100 * 0.5 * 0.9},
+		{units.Magic, 100 * (100.0 / 150) * 0.9},
+		{units.True, 100 * 0.9},
+	}
+	for _, c := range cases {
+		res := e.DealDamage(src, dst, Damage{Type: c.typ, Amount: 100})
+		if !near(res.PostMitigation, c.want) {
+			t.Fatalf("%s: got %v want %v", c.typ, res.PostMitigation, c.want)
+		}
+	}
+}
+
+func TestEngine_TargetPriorityBreaksDistanceTies(t *testing.T) {
+	t.Parallel()
+	e := New(Config{})
+	a := e.Add(testUnit(t, "A", units.WithAD(1), units.WithAS(1)), 0, Hex{0, 0})
+	e.Add(dummy(t, "low", units.WithTargetPriority(-1)), 1, Hex{1, 0})
+	high := e.Add(dummy(t, "high", units.WithTargetPriority(1)), 1, Hex{0, 1})
+	e.RunUntil(0)
+	if a.Target() != high {
+		t.Fatalf("expected tie to go to the higher target priority")
+	}
+}
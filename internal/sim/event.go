@@ -0,0 +1,62 @@
+package sim
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+	"github.com/google/uuid"
+)
+
+// EventKind names an entry of the structured combat log.
+type EventKind string
+
+const (
+	EventMove            EventKind = "move"
+	EventAttack          EventKind = "attack"
+	EventDamage          EventKind = "damage"
+	EventDeath           EventKind = "death"
+	EventEffectApplied   EventKind = "effect_applied"
+	EventEffectExpired   EventKind = "effect_expired"
+	EventEffectTick      EventKind = "effect_tick"
+	EventEffectRejected  EventKind = "effect_rejected"
+	EventCast            EventKind = "cast"
+	EventCastInterrupted EventKind = "cast_interrupted"
+	EventHeal            EventKind = "heal"
+	EventShieldApplied   EventKind = "shield_applied"
+	EventShieldBroken    EventKind = "shield_broken"
+	EventShieldExpired   EventKind = "shield_expired" // Amount is the unused (decayed) part
+	EventSummon          EventKind = "summon"         // Source summoned Target at Pos
+	EventSummonExpired   EventKind = "summon_expired" // Source's lifetime ran out
+)
+
+// SourceKind tags what produced a damage instance.
+type SourceKind string
+
+const (
+	SourceAutoAttack SourceKind = "auto_attack"
+	SourceAbility    SourceKind = "ability"
+	SourceEffect     SourceKind = "effect" // damage over time from a status effect
+	SourceBurn       SourceKind = "burn"
+	SourceOnHit      SourceKind = "on_hit" // OnHit hooks that do not declare a kind
+	SourceItem       SourceKind = "item"
+	SourceTrait      SourceKind = "trait"
+	SourceAugment    SourceKind = "augment"
+	SourcePowerUp    SourceKind = "power_up"
+	SourceSummon     SourceKind = "summon" // anything a summon deals, credited to its owner
+)
+
+// Event is one entry of the combat log. Optional fields are omitted when empty.
+type Event struct {
+	T          float64          `json:"t"`
+	Kind       EventKind        `json:"kind"`
+	Source     uuid.UUID        `json:"source"`
+	Target     uuid.UUID        `json:"target,omitzero"`
+	Amount     float64          `json:"amount,omitempty"`
+	Absorbed   float64          `json:"absorbed,omitempty"` // damage events: part taken by shields
+	Overheal   float64          `json:"overheal,omitempty"` // heal events: healing past max HP
+	DamageType units.DamageType `json:"damage_type,omitempty"`
+	SourceKind SourceKind       `json:"source_kind,omitempty"`
+	Label      string           `json:"label,omitempty"` // damage attribution (hook or effect name)
+	Crit       bool             `json:"crit,omitempty"`
+	Effect     string           `json:"effect,omitempty"`
+	Stacks     int              `json:"stacks,omitempty"`
+	Pos        *Hex             `json:"pos,omitempty"`
+}
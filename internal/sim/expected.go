@@ -0,0 +1,56 @@
+package sim
+
+import (
+	"math"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// DPSEstimate is a closed-form steady-state DPS estimate.
+type DPSEstimate struct {
+	AttackDPS  float64 `json:"attack_dps"`  // auto attacks, including time lost casting
+	AbilityDPS float64 `json:"ability_dps"` // spell damage on the primary target / cast cycle
+	Total      float64 `json:"total"`
+
+	CritMultiplier float64 `json:"crit_multiplier"` // expected damage multiplier from crits
+	AttackInterval float64 `json:"attack_interval"`
+	CastCycle      float64 `json:"cast_cycle"` // seconds from one cast to the next (0 → never casts)
+}
+
+// ExpectedDPS estimates the steady-state DPS of u against a target with the
+// given defenses, without simulating:
+//
+//	hit      = AD × (1 + DamageAmp) × (1 + crit% × (critDmg − 1)) × mitigation
+//	interval = max(MinInterval, 1 / min(AS, ASCap))
+//	cycle    = (ManaMax − ManaMin) / (ManaPerHit / interval + ManaRegen) + CastTime
+//
+// Auto attacks stop while casting, and the spell hits the primary target once
+// per cycle. Movement, CC, on-hit hooks and mana from damage taken are
+// ignored, so a Monte-Carlo DPS well below this points at pathing or
+// targeting; one above it at a modelling bug.
+func ExpectedDPS(u units.Unit, ability *abilities.Ability, star int, target units.DefenseStats, timing AttackTiming) DPSEstimate {
+	timing = timing.withDefaults()
+	off, res := u.Stats.Offense, u.Stats.Resource
+	est := DPSEstimate{CritMultiplier: 1 + math.Min(1, off.CritChance)*(off.CritDamage-1)}
+	if off.AS <= 0 {
+		return est
+	}
+	est.AttackInterval = timing.interval(off.AS)
+	hit := mitigate(off.AD*(1+off.DamageAmp)*est.CritMultiplier, units.Physical, target)
+	attackDPS := hit / est.AttackInterval
+
+	manaRate := res.ManaPerHit/est.AttackInterval + res.ManaRegen
+	if ability == nil || res.ManaMax <= 0 || manaRate <= 0 {
+		est.AttackDPS, est.Total = attackDPS, attackDPS
+		return est
+	}
+	fill := (res.ManaMax - res.ManaMin) / manaRate
+	est.CastCycle = fill + ability.CastTime
+	est.AttackDPS = attackDPS * fill / est.CastCycle
+	if c, err := abilities.Resolve(*ability, max(star, 1), u.Stats); err == nil && len(c.Instances) > 0 {
+		est.AbilityDPS = mitigate(c.Instances[0].Amount, c.Instances[0].Type, target) / est.CastCycle
+	}
+	est.Total = est.AttackDPS + est.AbilityDPS
+	return est
+}
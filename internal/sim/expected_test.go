@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"math"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestExpectedDPS_AttacksOnly(t *testing.T) {
+	t.Parallel()
+	u := testUnit(t, "x", units.WithAD(100), units.WithAS(1), units.WithCritChance(0.25), units.WithCritDamage(1.4))
+	est := ExpectedDPS(u, nil, 1, units.DefenseStats{Armor: 100}, AttackTiming{})
+	if !near(est.CritMultiplier, 1.1) || !near(est.Total, 55) || est.CastCycle != 0 {
+		t.Fatalf("estimate: %+v", est)
+	}
+
+	fast := testUnit(t, "fast", units.WithAD(10), units.WithAS(10), units.WithCritChance(0))
+	if est := ExpectedDPS(fast, nil, 1, units.DefenseStats{}, AttackTiming{}); !near(est.AttackInterval, 0.2) || !near(est.Total, 50) {
+		t.Fatalf("AS cap: %+v", est)
+	}
+}
+
+func TestExpectedDPS_CastCycle(t *testing.T) {
+	t.Parallel()
+	u := testUnit(t, "x", units.WithAD(100), units.WithAS(1), units.WithCritChance(0), units.WithMana(0, 40, 0, 0, 10))
+	bolt := testAbility(1)
+	est := ExpectedDPS(u, &bolt, 1, units.DefenseStats{Armor: 100}, AttackTiming{})
+	// 4s to fill + 1s cast: attacks 4/5 of the time (50 × 0.8), spell 100 true / 5s
+	if !near(est.CastCycle, 5) || !near(est.AttackDPS, 40) || !near(est.AbilityDPS, 20) || !near(est.Total, 60) {
+		t.Fatalf("estimate: %+v", est)
+	}
+}
+
+func TestExpectedDPS_MatchesMonteCarlo(t *testing.T) {
+	t.Parallel()
+	bolt := testAbility(1)
+	s := Scenario{
+		Name: "steady state",
+		Blue: Board{{Unit: testUnit(t, "carry", units.WithAD(80), units.WithAS(1.2), units.WithMana(0, 60, 0, 0, 10)),
+			Pos: Hex{0, 0}, Ability: &bolt, Star: 2}},
+		Red:    Board{{Unit: dummy(t, "dummy", units.WithArmor(50)), Pos: Hex{0, 1}}},
+		Config: Config{Seed: 5, MaxTime: 60},
+	}
+	mc, err := MonteCarlo(s, MonteCarloConfig{Runs: 50})
+	if err != nil {
+		t.Fatalf("mc: %v", err)
+	}
+	carry := mc.Units[0]
+	if carry.Expected.Total <= 0 || math.Abs(carry.Divergence) > 0.05 {
+		t.Fatalf("simulated %.1f vs expected %.1f DPS (divergence %.1f%%)", carry.DPS, carry.Expected.Total, 100*carry.Divergence)
+	}
+}
@@ -0,0 +1,123 @@
+package sim
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Fighter is a unit's runtime state in one combat.
+type Fighter struct {
+	Unit units.Unit
+	Team int
+	Pos  Hex
+
+	index   int         // insertion order; deterministic tie-breaker
+	hp      float64     // current HP
+	maxHP   float64     // HP at combat start (after effects at that time)
+	stats   units.Stats // effective stats: Unit.Stats + active effect modifiers
+	effects []*effectInstance
+	shields []*shieldInstance
+	target  *Fighter
+	alive   bool
+	diedAt  float64
+	tally   Tally
+	dealt   map[BreakdownKey]float64
+	onHit   []registeredHook // sorted by priority, then registration order
+	hooks   []CombatHook     // registration order
+
+	// Summons: owner is nil for board units (see Engine.Summon).
+	owner        *Fighter
+	untargetable bool
+	countTraits  bool
+	summoned     int // units this fighter has summoned
+
+	ability *abilities.Ability // nil → never casts
+	star    int
+	mana    float64
+	casting bool
+	castGen uint64 // bumped when a cast ends or is interrupted
+
+	// Action loop: only the act scheduled with the current actGen runs.
+	actGen uint64
+
+	// Attack timer: the next attack may start at attackReadyAt. attackGen is
+	// bumped by hard CC to cancel an attack still in its windup.
+	attackReadyAt float64
+	attackGen     uint64
+	windupEnd     float64 // an attack started before this time is still winding up
+	timerLeft     float64 // time left on the attack timer when hard CC paused it
+	castStart     float64
+}
+
+// FighterOption configures a fighter when it is added to the board.
+type FighterOption func(*Fighter)
+
+// WithAbility gives the fighter a spell cast at full mana, at the given star level.
+func WithAbility(a abilities.Ability, star int) FighterOption {
+	return func(f *Fighter) {
+		f.ability = &a
+		f.star = star
+	}
+}
+
+func newFighter(u units.Unit, team int, pos Hex, index int) *Fighter {
+	return &Fighter{
+		Unit:  u,
+		Team:  team,
+		Pos:   pos,
+		index: index,
+		hp:    u.Stats.Defense.HP,
+		maxHP: u.Stats.Defense.HP,
+		stats: u.Stats,
+		alive: true,
+		mana:  u.Stats.Resource.ManaStart,
+		star:  1,
+	}
+}
+
+// Stats returns the effective stats (base stats plus active effects).
+func (f *Fighter) Stats() units.Stats { return f.stats }
+
+func (f *Fighter) HP() float64    { return f.hp }
+func (f *Fighter) MaxHP() float64 { return f.maxHP }
+func (f *Fighter) Alive() bool    { return f.alive }
+func (f *Fighter) Mana() float64  { return f.mana }
+
+// Casting reports whether the fighter is locked in a cast.
+func (f *Fighter) Casting() bool { return f.casting }
+
+// Target returns the current attack target (nil if none).
+func (f *Fighter) Target() *Fighter { return f.target }
+
+// HasEffect reports whether an effect with that name is active.
+func (f *Fighter) HasEffect(name string) bool {
+	for _, inst := range f.effects {
+		if inst.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Stacks returns the total stacks of an effect across all of its instances.
+func (f *Fighter) Stacks(name string) int {
+	n := 0
+	for _, inst := range f.effects {
+		if inst.Name == name {
+			n += inst.stacks
+		}
+	}
+	return n
+}
+
+// Shield returns the total remaining shield amount, every damage type included.
+func (f *Fighter) Shield() float64 {
+	total := 0.0
+	for _, sh := range f.shields {
+		total += sh.remaining
+	}
+	return total
+}
+
+// Tally returns the fighter's running combat totals.
+func (f *Fighter) Tally() Tally { return f.tally }
@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Board geometry: each team owns HalfRows rows of BoardCols hexes on an
+// offset layout. Blue holds rows 0..3 (row 3 is its front line), red rows
+// 4..7 (row 4 is its front line).
+const (
+	BoardCols = 7
+	HalfRows  = 4
+)
+
+// Cell is the hex at column col of row row on the offset board layout.
+func Cell(col, row int) Hex { return Hex{Q: col - row/2, R: row} }
+
+// TeamHexes lists a team's hexes, front row first and centre column first
+// within a row, so earlier hexes are closer to the enemy.
+func TeamHexes(team int) []Hex {
+	out := make([]Hex, 0, BoardCols*HalfRows)
+	for i := range HalfRows {
+		row := HalfRows - 1 - i
+		if team != TeamBlue {
+			row = HalfRows + i
+		}
+		cols := make([]int, BoardCols)
+		for c := range cols {
+			cols[c] = c
+		}
+		slices.SortStableFunc(cols, func(a, b int) int { return cmp.Compare(abs(2*a-BoardCols+1), abs(2*b-BoardCols+1)) })
+		for _, c := range cols {
+			out = append(out, Cell(c, row))
+		}
+	}
+	return out
+}
+
+// OnTeamSide reports whether h is one of the team's hexes.
+func OnTeamSide(team int, h Hex) bool {
+	return slices.Contains(TeamHexes(team), h)
+}
+
+// Formation places b on the team's side: melee units (range <= 1) fill the
+// front rows, ranged units the back rows, centre first and in board order.
+// Existing positions are overwritten; units past the 28th stay where they were.
+func Formation(team int, b Board) Board {
+	hexes := TeamHexes(team)
+	back := slices.Clone(hexes)
+	slices.SortStableFunc(back, func(x, y Hex) int { return cmp.Compare(abs(y.R-hexes[0].R), abs(x.R-hexes[0].R)) })
+	used := map[Hex]bool{}
+	take := func(order []Hex) (Hex, bool) {
+		for _, h := range order {
+			if !used[h] {
+				used[h] = true
+				return h, true
+			}
+		}
+		return Hex{}, false
+	}
+	out := slices.Clone(b)
+	for i := range out {
+		order := hexes
+		if out[i].Unit.Stats.Offense.Range > 1 {
+			order = back
+		}
+		if h, ok := take(order); ok {
+			out[i].Pos = h
+		}
+	}
+	return out
+}
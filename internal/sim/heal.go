@@ -0,0 +1,57 @@
+package sim
+
+import "math"
+
+// LabelOmnivamp attributes omnivamp healing in the event log.
+const LabelOmnivamp = "omnivamp"
+
+// HealResult reports how a heal resolved.
+type HealResult struct {
+	Raw       float64 // requested amount
+	Prevented float64 // removed by healing reduction (Wound)
+	Effective float64 // HP actually restored
+	Overheal  float64 // healing past max HP
+}
+
+// Heal restores amount HP to dst from src (may be nil), labelled for the log.
+// Healing reduction applies first; healing is capped at max HP and the excess
+// is reported as overheal.
+func (e *Engine) Heal(src, dst *Fighter, amount float64, label string) HealResult {
+	res := HealResult{Raw: amount}
+	if dst == nil || !dst.alive || amount <= 0 {
+		return res
+	}
+	res.Prevented = amount * dst.healingReduction()
+	amount -= res.Prevented
+	res.Effective = math.Min(amount, math.Max(0, dst.maxHP-dst.hp))
+	res.Overheal = amount - res.Effective
+	dst.hp += res.Effective
+
+	dst.tally.HealingReceived += res.Effective
+	dst.tally.HealingPrevented += res.Prevented
+	if src != nil {
+		src.tally.HealingDone += res.Effective
+		src.tally.Overheal += res.Overheal
+	}
+	e.emit(Event{Kind: EventHeal, Source: fighterID(src), Target: dst.Unit.ID, Amount: res.Effective, Overheal: res.Overheal, Label: label})
+	return res
+}
+
+// omnivamp heals src for its current omnivamp × the damage it just dealt.
+func (e *Engine) omnivamp(src *Fighter, dealt float64) {
+	if src == nil || dealt <= 0 {
+		return
+	}
+	if ov := src.stats.Offense.Omnivamp.CurrentOmnivamp; ov > 0 {
+		e.Heal(src, src, ov*dealt, LabelOmnivamp)
+	}
+}
+
+// healingReduction is the strongest active healing reduction, in [0,1].
+func (f *Fighter) healingReduction() float64 {
+	r := 0.0
+	for _, inst := range f.effects {
+		r = math.Max(r, inst.HealingReduction)
+	}
+	return math.Min(r, 1)
+}
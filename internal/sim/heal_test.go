@@ -0,0 +1,72 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestHeal_CappedAtMaxHPWithOverheal(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 500})
+	res := e.Heal(src, dst, 800, "Heal")
+	if !near(res.Effective, 500) || !near(res.Overheal, 300) || !near(dst.HP(), dst.MaxHP()) {
+		t.Fatalf("heal: %+v HP=%v", res, dst.HP())
+	}
+	if tl := src.Tally(); !near(tl.HealingDone, 500) || !near(tl.Overheal, 300) {
+		t.Fatalf("healer tally: %+v", tl)
+	}
+}
+
+func TestHeal_WoundReducesHealing(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 1000})
+	e.ApplyEffect(src, dst, Wound(DefaultWound, 5))
+	res := e.Heal(dst, dst, 300, "Heal")
+	if !near(res.Effective, 201) || !near(res.Prevented, 99) {
+		t.Fatalf("33%% wound: %+v", res)
+	}
+	e.RunUntil(6)
+	if res := e.Heal(dst, dst, 300, "Heal"); !near(res.Effective, 300) {
+		t.Fatalf("wound expired: %+v", res)
+	}
+	if !near(dst.Tally().HealingPrevented, 99) {
+		t.Fatalf("prevented healing tally: %+v", dst.Tally())
+	}
+}
+
+func TestHeal_Omnivamp(t *testing.T) {
+	t.Parallel()
+	e := New(Config{MaxTime: 10})
+	a := e.Add(testUnit(t, "vamp", units.WithHP(1000), units.WithAD(100), units.WithAS(1), units.WithCritChance(0),
+		units.WithOmnivampValues(0.08, 0.20, 0.20)), 0, Hex{0, 0})
+	d := e.Add(dummy(t, "dummy", units.WithArmor(100)), 1, Hex{0, 1})
+	e.DealDamage(d, a, Damage{Type: units.True, Amount: 300})
+	e.RunUntil(0)
+	// 100 AD vs 100 armor → 50 dealt → 20% = 10 healed
+	if !near(a.HP(), 710) {
+		t.Fatalf("omnivamp should heal 10, HP=%v", a.HP())
+	}
+	heals := 0
+	for _, ev := range e.Log() {
+		if ev.Kind == EventHeal && ev.Label == LabelOmnivamp {
+			heals++
+		}
+	}
+	if heals != 1 {
+		t.Fatalf("omnivamp heal must be logged, got %d", heals)
+	}
+}
+
+func TestHeal_RegenTicks(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 1000})
+	e.ApplyEffect(dst, dst, Regen("", 50, 3))
+	e.RunUntil(5)
+	if !near(dst.HP(), 9150) || !near(e.Result().Fighters[dst.index].HealingReceived, 150) {
+		t.Fatalf("3s of 50/s regen: HP=%v", dst.HP())
+	}
+}
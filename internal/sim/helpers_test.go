@@ -0,0 +1,63 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+var testIDs = units.NewDeterministicIDs("sim-test", 1)
+
+// testUnit builds a unit with a deterministic ID from its name; Range defaults to 1.
+func testUnit(t *testing.T, name string, opts ...units.Option) units.Unit {
+	t.Helper()
+	s, err := units.NewStats(append([]units.Option{units.WithRange(1)}, opts...)...)
+	if err != nil {
+		t.Fatalf("stats for %s: %v", name, err)
+	}
+	return units.Unit{ID: testIDs.UnitID(name, 0), Name: name, Stats: s}
+}
+
+// dummy is a passive, tanky target that never attacks.
+func dummy(t *testing.T, name string, opts ...units.Option) units.Unit {
+	t.Helper()
+	return testUnit(t, name, append([]units.Option{units.WithHP(10000)}, opts...)...)
+}
+
+func countEvents(log []Event, kind EventKind, effect string) int {
+	n := 0
+	for _, ev := range log {
+		if ev.Kind == kind && (effect == "" || ev.Effect == effect) {
+			n++
+		}
+	}
+	return n
+}
+
+func near(a, b float64) bool {
+	d := a - b
+	return d < 1e-6 && d > -1e-6
+}
+
+// eventTimes returns the timestamps of events of kind emitted by src.
+func eventTimes(log []Event, kind EventKind, src *Fighter) []float64 {
+	var ts []float64
+	for _, ev := range log {
+		if ev.Kind == kind && ev.Source == src.Unit.ID {
+			ts = append(ts, ev.T)
+		}
+	}
+	return ts
+}
+
+func sameTimes(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if !near(got[i], want[i]) {
+			return false
+		}
+	}
+	return true
+}
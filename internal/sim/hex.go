@@ -0,0 +1,35 @@
+package sim
+
+// Hex is an axial hex coordinate (pointy-top). Distance is in hexes.
+type Hex struct {
+	Q int `json:"q"`
+	R int `json:"r"`
+}
+
+// hexDirections in a fixed order so movement tie-breaks are deterministic.
+var hexDirections = [6]Hex{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}}
+
+func (h Hex) Add(o Hex) Hex { return Hex{h.Q + o.Q, h.R + o.R} }
+
+// Distance returns the hex distance between h and o.
+func (h Hex) Distance(o Hex) int {
+	dq, dr := h.Q-o.Q, h.R-o.R
+	ds := -dq - dr
+	return max(abs(dq), abs(dr), abs(ds))
+}
+
+// Neighbors returns the six adjacent hexes in a fixed order.
+func (h Hex) Neighbors() [6]Hex {
+	var out [6]Hex
+	for i, d := range hexDirections {
+		out[i] = h.Add(d)
+	}
+	return out
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
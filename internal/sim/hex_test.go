@@ -0,0 +1,46 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestHex_DistanceAndNeighbors(t *testing.T) {
+	t.Parallel()
+	o := Hex{0, 0}
+	if d := o.Distance(Hex{3, -1}); d != 3 {
+		t.Fatalf("distance: got %d want 3", d)
+	}
+	if d := (Hex{2, 1}).Distance(Hex{-1, 2}); d != 3 {
+		t.Fatalf("distance: got %d want 3", d)
+	}
+	for _, n := range o.Neighbors() {
+		if o.Distance(n) != 1 {
+			t.Fatalf("neighbor %v not adjacent", n)
+		}
+	}
+}
+
+func TestTeamHexes_HalvesAndFormation(t *testing.T) {
+	t.Parallel()
+	blue, red := TeamHexes(TeamBlue), TeamHexes(TeamRed)
+	if len(blue) != BoardCols*HalfRows || len(red) != BoardCols*HalfRows {
+		t.Fatalf("half sizes: %d, %d", len(blue), len(red))
+	}
+	for _, h := range blue {
+		if OnTeamSide(TeamRed, h) {
+			t.Fatalf("hex %v on both sides", h)
+		}
+	}
+	if blue[0].R != HalfRows-1 || red[0].R != HalfRows {
+		t.Fatalf("front rows first: blue %v, red %v", blue[0], red[0])
+	}
+	b := Formation(TeamBlue, Board{
+		{Unit: testUnit(t, "archer", units.WithRange(4))},
+		{Unit: testUnit(t, "tank")},
+	})
+	if b[1].Pos != blue[0] || b[0].Pos.R != 0 {
+		t.Fatalf("melee in front, ranged in the back row: %v, %v", b[1].Pos, b[0].Pos)
+	}
+}
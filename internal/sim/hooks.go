@@ -0,0 +1,88 @@
+package sim
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Trigger decides when a CombatHook fires for its fighter.
+type Trigger string
+
+const (
+	TriggerStart Trigger = "start_of_combat" // once, before the first action
+	TriggerCast  Trigger = "on_cast"         // after each of the fighter's spells lands
+	TriggerEvery Trigger = "every"           // every CombatHook.Every seconds while alive
+)
+
+// CombatHook is a behaviour that fires on combat events other than hits
+// (augments, traits, item actives). Damage it deals is tagged Kind
+// ("" → SourceEffect) and labelled Name.
+type CombatHook struct {
+	Name    string
+	Kind    SourceKind
+	Trigger Trigger
+	Every   float64 // seconds between TriggerEvery firings (<= 0 → never)
+	Fn      func(c *HookContext)
+}
+
+// HookContext describes a firing to a CombatHook.
+type HookContext struct {
+	Engine *Engine
+	Self   *Fighter
+
+	hook CombatHook
+}
+
+// Damage deals damage from the hook's fighter to t, attributed to the hook.
+func (c *HookContext) Damage(t *Fighter, typ units.DamageType, amount float64) DamageResult {
+	kind := c.hook.Kind
+	if kind == "" {
+		kind = SourceEffect
+	}
+	return c.Engine.DealDamage(c.Self, t, Damage{Type: typ, Amount: amount, Source: kind, Label: c.hook.Name})
+}
+
+// Target is the fighter's living attack target, or the nearest enemy.
+func (c *HookContext) Target() *Fighter {
+	if t := c.Self.target; t != nil && t.alive {
+		return t
+	}
+	return c.Engine.acquireTarget(c.Self)
+}
+
+// GainMana grants the fighter mana.
+func (c *HookContext) GainMana(amount float64) { c.Engine.gainMana(c.Self, amount) }
+
+// WithHooks registers combat hooks on a fighter when it is added.
+func WithHooks(hooks ...CombatHook) FighterOption {
+	return func(f *Fighter) { f.hooks = append(f.hooks, hooks...) }
+}
+
+// fireHooks runs f's hooks with the given trigger, in registration order.
+func (e *Engine) fireHooks(f *Fighter, tr Trigger) {
+	for _, h := range f.hooks {
+		if !f.alive {
+			return
+		}
+		if h.Trigger == tr && h.Fn != nil {
+			h.Fn(&HookContext{Engine: e, Self: f, hook: h})
+		}
+	}
+}
+
+// scheduleEvery arms f's TriggerEvery hooks; each keeps its own cadence.
+func (e *Engine) scheduleEvery(f *Fighter) {
+	for _, h := range f.hooks {
+		if h.Trigger != TriggerEvery || h.Every <= 0 || h.Fn == nil {
+			continue
+		}
+		var tick func()
+		tick = func() {
+			if !f.alive {
+				return
+			}
+			h.Fn(&HookContext{Engine: e, Self: f, hook: h})
+			e.After(h.Every, tick)
+		}
+		e.After(h.Every, tick)
+	}
+}
@@ -0,0 +1,45 @@
+package sim
+
+import (
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+)
+
+// ItemHooks turns the passives of held items into on-hit hooks tagged
+// SourceItem and named after the item.
+func ItemHooks(held ...items.Item) []OnHit {
+	var out []OnHit
+	for _, it := range held {
+		p := it.Passive
+		if p == nil {
+			continue
+		}
+		var base OnHit
+		switch p.Kind {
+		case items.PassiveBonusDamage:
+			base = BonusDamageOnHit(it.Name, p.DamageType, p.Amount)
+		case items.PassiveManaOnHit:
+			base = ManaOnHit(it.Name, p.Amount)
+		case items.PassiveStackingAS:
+			base = StackingASOnHit(it.Name, p.Amount, p.MaxStacks)
+		default:
+			continue
+		}
+		out = append(out, OnHitFunc{HookName: it.Name, Kind: SourceItem, Fn: base.OnHit})
+	}
+	return out
+}
+
+// equipped is the placement's unit with its power-up, then holding its items.
+func (p Placement) equipped() (Placement, error) {
+	p, err := p.poweredUp()
+	if err != nil || len(p.Items) == 0 {
+		return p, err
+	}
+	u, err := items.Equip(p.Unit, p.Items...)
+	if err != nil {
+		return Placement{}, err
+	}
+	p.Unit = u
+	p.OnHit = append(append([]OnHit(nil), p.OnHit...), ItemHooks(p.Items...)...)
+	return p, nil
+}
@@ -0,0 +1,98 @@
+package sim
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestPlacementItems_StatsAndPassives(t *testing.T) {
+	t.Parallel()
+	catalog, err := items.LoadItems("../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load items: %v", err)
+	}
+	kraken, _ := catalog.Lookup("Kraken's Fury")
+	s := Scenario{
+		Name: "items",
+		Blue: Board{{Unit: testUnit(t, "carry", units.WithAD(100), units.WithAS(1), units.WithCritChance(0)), Pos: Hex{0, 0},
+			Items: []items.Item{kraken}}},
+		Red:    Board{{Unit: dummy(t, "dummy"), Pos: Hex{0, 1}}},
+		Config: Config{MaxTime: 3},
+	}
+	e, err := s.Engine()
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	carry := e.Fighters()[0]
+	if got := carry.Unit.Stats.Offense.AD; got < 109.999 || got > 110.001 {
+		t.Fatalf("AD with Kraken's Fury = %v, want 110", got)
+	}
+	e.Run()
+	var onItem float64
+	for _, row := range e.Result().Fighters[0].Breakdown {
+		if row.Source == SourceItem && row.Label == "Kraken's Fury" {
+			onItem += row.Amount
+		}
+	}
+	if onItem <= 0 {
+		t.Fatalf("no item damage in %v", e.Result().Fighters[0].Breakdown)
+	}
+
+	s.Blue[0].Items = []items.Item{kraken, kraken, kraken, kraken}
+	if err := s.Validate(); err == nil {
+		t.Fatalf("expected an error for four items")
+	}
+}
+
+func TestScenarioTraits_EmblemsCount(t *testing.T) {
+	t.Parallel()
+	catalog, err := items.LoadItems("../config/set15/items.json")
+	if err != nil {
+		t.Fatalf("load items: %v", err)
+	}
+	traits, err := units.LoadTraits("../config/set15/traits.json")
+	if err != nil {
+		t.Fatalf("load traits: %v", err)
+	}
+	emblem, _ := catalog.Lookup("Sniper Emblem")
+	sniper := func(name string) units.Unit {
+		u := testUnit(t, name)
+		u.Traits = []string{"Sniper"}
+		return u
+	}
+	tank := testUnit(t, "tank")
+	tank.Traits = []string{"Bastion"}
+	s := Scenario{
+		Name: "emblem",
+		Blue: Board{
+			{Unit: sniper("a"), Pos: Hex{0, 0}},
+			{Unit: sniper("b"), Pos: Hex{1, 0}},
+			{Unit: tank, Pos: Hex{0, 1}, Items: []items.Item{emblem}},
+		},
+		Red:      Board{{Unit: dummy(t, "dummy"), Pos: Hex{0, 5}}},
+		Augments: [2][]augments.Augment{TeamBlue: {{Name: "Crest", Tier: augments.Gold, Traits: []string{"Sniper"}}}},
+	}
+	active, err := s.Traits(TeamBlue, traits)
+	if err != nil {
+		t.Fatalf("traits: %v", err)
+	}
+	if active[0].Name != "Sniper" || active[0].Count != 4 || active[0].Breakpoint != 4 {
+		t.Fatalf("2 snipers + emblem + crest: %+v", active)
+	}
+	e, err := s.Engine()
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	if got := e.Traits(TeamBlue, traits); !reflect.DeepEqual(got, active) {
+		t.Fatalf("engine traits %+v, scenario traits %+v", got, active)
+	}
+
+	s.Blue[0].Items = []items.Item{emblem}
+	if err := s.Validate(); err == nil {
+		t.Fatalf("expected an error for a Sniper Emblem on a Sniper")
+	}
+}
@@ -0,0 +1,36 @@
+package sim
+
+import "math"
+
+// gainMana adds mana to f, capped at ManaMax. Casting fighters gain none.
+func (e *Engine) gainMana(f *Fighter, amount float64) {
+	if !f.alive || f.casting || amount <= 0 {
+		return
+	}
+	f.mana = math.Min(f.mana+amount, f.stats.Resource.ManaMax)
+}
+
+// manaFromDamage grants mana for taking a hit when the unit's ManaFromDamage
+// rule is enabled: pre×PreRatio + post×PostRatio, capped per instance.
+func (e *Engine) manaFromDamage(f *Fighter, pre, post float64) {
+	r := f.stats.Resource.ManaFromDamage
+	if !r.Enabled {
+		return
+	}
+	gain := pre*r.PreMitigationRatio + post*r.PostMitigationRatio
+	if r.PerInstanceCap > 0 {
+		gain = math.Min(gain, r.PerInstanceCap)
+	}
+	e.gainMana(f, gain)
+}
+
+// scheduleRegen grants ManaRegen every second while f is alive.
+func (e *Engine) scheduleRegen(f *Fighter) {
+	e.After(1, func() {
+		if !f.alive {
+			return
+		}
+		e.gainMana(f, f.stats.Resource.ManaRegen)
+		e.scheduleRegen(f)
+	})
+}
@@ -0,0 +1,163 @@
+package sim
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// MonteCarloConfig tunes MonteCarlo. Zero fields take the defaults.
+type MonteCarloConfig struct {
+	Runs    int // number of fights (default 1000)
+	Workers int // parallel workers (default GOMAXPROCS)
+}
+
+const defaultRuns = 1000
+
+// UnitSummary is one unit's mean performance over every run.
+type UnitSummary struct {
+	Name        string    `json:"name"`
+	Team        int       `json:"team"`
+	DamageDealt float64   `json:"damage_dealt"`
+	DamageTaken float64   `json:"damage_taken"`
+	HealingDone float64   `json:"healing_done"`
+	Absorbed    float64   `json:"shield_absorbed"`
+	DPS         float64   `json:"dps"`
+	TimeAlive   float64   `json:"time_alive"`
+	Survival    float64   `json:"survival"`  // fraction of runs the unit survived
+	Breakdown   Breakdown `json:"breakdown"` // mean damage per line; shares over all runs
+
+	// Expected is the closed-form estimate against the enemy board's mean
+	// defenses; Divergence = DPS / Expected.Total − 1 (0 when no estimate).
+	Expected   DPSEstimate `json:"expected"`
+	Divergence float64     `json:"divergence"`
+}
+
+// MonteCarloResult aggregates many seeded runs of one scenario.
+type MonteCarloResult struct {
+	Runs     int             `json:"runs"`
+	Duration float64         `json:"duration"` // mean
+	WinRate  map[int]float64 `json:"win_rate"` // by team; timeouts count for nobody
+	Units    []UnitSummary   `json:"units"`    // scenario order: blue then red
+}
+
+// MonteCarlo runs s cfg.Runs times on a worker pool. Run i uses seed
+// s.Config.Seed + i, and results are merged in run order, so the output does
+// not depend on the worker count.
+func MonteCarlo(s Scenario, cfg MonteCarloConfig) (MonteCarloResult, error) {
+	results, err := RunMany(s, cfg)
+	if err != nil {
+		return MonteCarloResult{}, err
+	}
+	out := aggregate(results)
+	for i, p := range append(append(Board{}, s.Blue...), s.Red...) {
+		enemies := s.Red
+		if i >= len(s.Blue) {
+			enemies = s.Blue
+		}
+		p, _ = p.equipped() // checked by Validate
+		u := &out.Units[i]
+		u.Expected = ExpectedDPS(p.Unit, p.Ability, p.Star, MeanDefense(enemies), s.Config.Timing)
+		if u.Expected.Total > 0 {
+			u.Divergence = u.DPS/u.Expected.Total - 1
+		}
+	}
+	return out, nil
+}
+
+// RunMany runs s cfg.Runs times like MonteCarlo and returns every result in
+// run order, for callers that need per-run distributions.
+func RunMany(s Scenario, cfg MonteCarloConfig) ([]Result, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Runs <= 0 {
+		cfg.Runs = defaultRuns
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	results := make([]Result, cfg.Runs)
+	errs := make([]error, cfg.Runs)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(cfg.Workers, cfg.Runs) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				run := s
+				run.Config.Seed = s.Config.Seed + uint64(i)
+				results[i], errs[i] = run.Run()
+			}
+		}()
+	}
+	for i := range cfg.Runs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("run %d: %w", i, err)
+		}
+	}
+	return results, nil
+}
+
+// MeanDefense averages the resistances of a board, items included.
+func MeanDefense(b Board) units.DefenseStats {
+	var d units.DefenseStats
+	for _, p := range b {
+		if eq, err := p.equipped(); err == nil {
+			p = eq
+		}
+		d.Armor += p.Unit.Stats.Defense.Armor / float64(len(b))
+		d.MR += p.Unit.Stats.Defense.MR / float64(len(b))
+		d.Durability += p.Unit.Stats.Defense.Durability / float64(len(b))
+	}
+	return d
+}
+
+func aggregate(results []Result) MonteCarloResult {
+	n := float64(len(results))
+	out := MonteCarloResult{Runs: len(results), WinRate: map[int]float64{}}
+	sums := make([]map[BreakdownKey]float64, len(results[0].Fighters))
+	out.Units = make([]UnitSummary, len(results[0].Fighters))
+	for i, fr := range results[0].Fighters {
+		out.Units[i] = UnitSummary{Name: fr.Name, Team: fr.Team}
+		sums[i] = map[BreakdownKey]float64{}
+	}
+	for _, team := range results[0].Teams {
+		out.WinRate[team.Team] = 0
+	}
+
+	for _, r := range results {
+		out.Duration += r.Duration / n
+		if r.Winner >= 0 {
+			out.WinRate[r.Winner] += 1 / n
+		}
+		for i, fr := range r.Fighters {
+			u := &out.Units[i]
+			u.DamageDealt += fr.DamageDealt / n
+			u.DamageTaken += fr.DamageTaken / n
+			u.HealingDone += fr.HealingDone / n
+			u.Absorbed += fr.ShieldAbsorbed / n
+			u.DPS += fr.DPS / n
+			u.TimeAlive += fr.TimeAlive / n
+			if fr.Alive {
+				u.Survival += 1 / n
+			}
+			for _, row := range fr.Breakdown {
+				sums[i][row.BreakdownKey] += row.Amount
+			}
+		}
+	}
+	for i := range out.Units {
+		out.Units[i].Breakdown = newBreakdown(sums[i], 1/n)
+	}
+	return out
+}
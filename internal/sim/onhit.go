@@ -0,0 +1,132 @@
+package sim
+
+import (
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// OnHit is a hook that runs after each of a fighter's auto attacks lands.
+// Items, traits, augments and abilities register hooks with WithOnHit or
+// Fighter.AddOnHit.
+type OnHit interface {
+	// Name attributes the hook's damage in the breakdown and event log.
+	Name() string
+	OnHit(h *Hit)
+}
+
+// Sourced hooks declare the source kind their damage is tagged with
+// (SourceItem, SourceTrait, ...); others are tagged SourceOnHit.
+type Sourced interface {
+	SourceKind() SourceKind
+}
+
+// Prioritized hooks run in ascending Priority order (default 0); hooks with
+// the same priority run in registration order.
+type Prioritized interface {
+	Priority() int
+}
+
+// Hit describes a landed auto attack to OnHit hooks.
+type Hit struct {
+	Engine           *Engine
+	Attacker, Target *Fighter
+	Crit             bool
+	Result           DamageResult // pre/post-mitigation values of the attack itself
+
+	hook string     // name of the running hook, for attribution
+	kind SourceKind // source kind of the running hook
+}
+
+// Damage deals extra damage from the running hook to the hit target. It goes
+// through the normal mitigation pipeline and is attributed to the hook.
+func (h *Hit) Damage(typ units.DamageType, amount float64) DamageResult {
+	return h.Engine.DealDamage(h.Attacker, h.Target, Damage{Type: typ, Amount: amount, Source: h.kind, Label: h.hook})
+}
+
+// ApplyEffect applies eff from the attacker to the hit target.
+func (h *Hit) ApplyEffect(eff Effect) { h.Engine.ApplyEffect(h.Attacker, h.Target, eff) }
+
+// GainMana grants the attacker mana.
+func (h *Hit) GainMana(amount float64) { h.Engine.gainMana(h.Attacker, amount) }
+
+type registeredHook struct {
+	hook     OnHit
+	priority int
+	kind     SourceKind
+}
+
+// WithOnHit registers on-hit hooks on a fighter when it is added.
+func WithOnHit(hooks ...OnHit) FighterOption {
+	return func(f *Fighter) {
+		for _, h := range hooks {
+			f.AddOnHit(h)
+		}
+	}
+}
+
+// AddOnHit registers an on-hit hook (see Prioritized for ordering).
+func (f *Fighter) AddOnHit(h OnHit) {
+	p, kind := 0, SourceOnHit
+	if pr, ok := h.(Prioritized); ok {
+		p = pr.Priority()
+	}
+	if s, ok := h.(Sourced); ok && s.SourceKind() != "" {
+		kind = s.SourceKind()
+	}
+	// Insert after every hook with priority <= p: stable, registration order.
+	i := 0
+	for i < len(f.onHit) && f.onHit[i].priority <= p {
+		i++
+	}
+	f.onHit = slices.Insert(f.onHit, i, registeredHook{hook: h, priority: p, kind: kind})
+}
+
+// runOnHit fires f's hooks for a landed attack.
+func (e *Engine) runOnHit(f, t *Fighter, crit bool, res DamageResult) {
+	if len(f.onHit) == 0 {
+		return
+	}
+	h := &Hit{Engine: e, Attacker: f, Target: t, Crit: crit, Result: res}
+	for _, r := range f.onHit {
+		if !f.alive {
+			return
+		}
+		h.hook, h.kind = r.hook.Name(), r.kind
+		r.hook.OnHit(h)
+	}
+}
+
+// OnHitFunc adapts a function to the OnHit interface.
+type OnHitFunc struct {
+	HookName string
+	Order    int        // Priority
+	Kind     SourceKind // "" → SourceOnHit
+	Fn       func(h *Hit)
+}
+
+func (o OnHitFunc) Name() string           { return o.HookName }
+func (o OnHitFunc) Priority() int          { return o.Order }
+func (o OnHitFunc) SourceKind() SourceKind { return o.Kind }
+func (o OnHitFunc) OnHit(h *Hit)           { o.Fn(h) }
+
+// BonusDamageOnHit deals amount of typ damage on every hit.
+func BonusDamageOnHit(name string, typ units.DamageType, amount float64) OnHit {
+	return OnHitFunc{HookName: name, Fn: func(h *Hit) { h.Damage(typ, amount) }}
+}
+
+// EffectOnHit applies eff to the target on every hit (e.g. Shred on hit).
+func EffectOnHit(name string, eff Effect) OnHit {
+	return OnHitFunc{HookName: name, Fn: func(h *Hit) { h.ApplyEffect(eff) }}
+}
+
+// ManaOnHit grants the attacker extra mana on every hit.
+func ManaOnHit(name string, amount float64) OnHit {
+	return OnHitFunc{HookName: name, Fn: func(h *Hit) { h.GainMana(amount) }}
+}
+
+// StackingASOnHit grants the attacker perStack attack speed per hit, up to maxStacks.
+func StackingASOnHit(name string, perStack float64, maxStacks int) OnHit {
+	ramp := AttackSpeedRamp(name, perStack, maxStacks)
+	return OnHitFunc{HookName: name, Fn: func(h *Hit) { h.Engine.ApplyEffect(h.Attacker, h.Attacker, ramp) }}
+}
@@ -0,0 +1,88 @@
+package sim
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// hitter returns an engine with an AS 1, 100 AD attacker next to a 100 armor/MR dummy.
+func hitter(t *testing.T, hooks ...OnHit) (*Engine, *Fighter, *Fighter) {
+	t.Helper()
+	e := New(Config{MaxTime: 10})
+	a := e.Add(testUnit(t, "attacker", units.WithAD(100), units.WithAS(1), units.WithCritChance(0)), 0, Hex{0, 0}, WithOnHit(hooks...))
+	d := e.Add(dummy(t, "dummy", units.WithArmor(100), units.WithMR(100)), 1, Hex{0, 1})
+	return e, a, d
+}
+
+func TestOnHit_OrderIsDeterministic(t *testing.T) {
+	t.Parallel()
+	var order []string
+	rec := func(name string, prio int) OnHit {
+		return OnHitFunc{HookName: name, Order: prio, Fn: func(*Hit) { order = append(order, name) }}
+	}
+	e, _, _ := hitter(t, rec("b", 0), rec("late", 10), rec("early", -5), rec("c", 0))
+	e.RunUntil(0)
+	if want := []string{"early", "b", "c", "late"}; !slices.Equal(order, want) {
+		t.Fatalf("order: got %v want %v", order, want)
+	}
+}
+
+func TestOnHit_SeesAttackValues(t *testing.T) {
+	t.Parallel()
+	var got Hit
+	e, a, d := hitter(t, OnHitFunc{HookName: "probe", Fn: func(h *Hit) { got = *h }})
+	e.RunUntil(0)
+	if got.Attacker != a || got.Target != d || got.Crit {
+		t.Fatalf("wrong hit context: %+v", got)
+	}
+	if !near(got.Result.PreMitigation, 100) || !near(got.Result.PostMitigation, 50) {
+		t.Fatalf("pre/post mitigation: %+v", got.Result)
+	}
+}
+
+func TestOnHit_BonusDamageMitigatedAndAttributed(t *testing.T) {
+	t.Parallel()
+	e, a, d := hitter(t, BonusDamageOnHit("Nashor", units.Magic, 40))
+	e.RunUntil(1.5) // two hits
+	if !near(d.HP(), 10000-2*(50+20)) {
+		t.Fatalf("on-hit magic damage goes through MR: HP=%v", d.HP())
+	}
+	by := e.Result().Fighters[a.index].DamageBySource
+	if !near(by["Nashor"], 40) || !near(by[string(SourceAutoAttack)], 100) {
+		t.Fatalf("breakdown: %v", by)
+	}
+	labelled := 0
+	for _, ev := range e.Log() {
+		if ev.Kind == EventDamage && ev.Label == "Nashor" && ev.SourceKind == SourceOnHit {
+			labelled++
+		}
+	}
+	if labelled != 2 {
+		t.Fatalf("each on-hit damage event is labelled, got %d", labelled)
+	}
+}
+
+func TestOnHit_BuiltinHooks(t *testing.T) {
+	t.Parallel()
+	e := New(Config{MaxTime: 10})
+	a := e.Add(testUnit(t, "attacker", units.WithAD(10), units.WithAS(1), units.WithMana(0, 100, 0, 0, 10)), 0, Hex{0, 0},
+		WithOnHit(
+			EffectOnHit("Last Whisper", Sunder(DefaultSunder, 3)),
+			ManaOnHit("Shojin", 5),
+			StackingASOnHit("Rageblade", 0.1, 5),
+		))
+	d := e.Add(dummy(t, "dummy", units.WithArmor(100)), 1, Hex{0, 1})
+	e.RunUntil(0.1)
+	if !d.HasEffect(EffectSunder) {
+		t.Fatalf("sunder on hit not applied")
+	}
+	if !near(a.Mana(), 15) {
+		t.Fatalf("10 mana per hit + 5 on hit, got %v", a.Mana())
+	}
+	e.RunUntil(2.5)
+	if n := a.Stacks("Rageblade"); n != 3 {
+		t.Fatalf("one AS stack per hit: got %d after 3 hits", n)
+	}
+}
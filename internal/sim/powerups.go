@@ -0,0 +1,40 @@
+package sim
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+)
+
+// PowerUpHooks turns a power-up's hooks into combat hooks tagged
+// SourcePowerUp and named after the power-up.
+func PowerUpHooks(p powerups.PowerUp) []CombatHook {
+	return combatHooks(p.Name, SourcePowerUp, p.Hooks)
+}
+
+// poweredUp applies the placement's power-up: its stats (unless the unit was
+// built with it), its hooks and its ability modifier.
+func (p Placement) poweredUp() (Placement, error) {
+	if p.PowerUp == nil {
+		return p, nil
+	}
+	switch {
+	case p.Unit.PowerUp == "":
+		u, err := powerups.Attach(p.Unit, *p.PowerUp)
+		if err != nil {
+			return Placement{}, err
+		}
+		p.Unit = u
+	case !strings.EqualFold(p.Unit.PowerUp, p.PowerUp.Name):
+		return Placement{}, fmt.Errorf("%s: built with power-up %q, placed with %q", p.Unit.Name, p.Unit.PowerUp, p.PowerUp.Name)
+	}
+	p.Hooks = append(slices.Clone(p.Hooks), PowerUpHooks(*p.PowerUp)...)
+	if p.Ability != nil && p.PowerUp.Ability != nil {
+		a := p.PowerUp.Ability.Apply(*p.Ability)
+		p.Ability = &a
+	}
+	p.PowerUp = nil
+	return p, nil
+}
@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestPlacementPowerUp_StatsHooksAndAbility(t *testing.T) {
+	t.Parallel()
+	pu := powerups.PowerUp{
+		Name:    "Hexxed",
+		Stats:   map[string]float64{"AD": 10},
+		Hooks:   []augments.Hook{{Trigger: augments.OnCast, Action: augments.ActionDamage, DamageType: units.Magic, Amount: 50}},
+		Ability: &powerups.AbilityMod{BaseMult: 1},
+	}
+	spell := testAbility(0)
+	u := testUnit(t, "caster", units.WithAD(20), units.WithMana(0, 30, 30, 0, 0))
+	s := Scenario{
+		Name:   "power-up",
+		Blue:   Board{{Unit: u, Pos: Hex{0, 0}, Ability: &spell, Star: 2, PowerUp: &pu}},
+		Red:    Board{{Unit: dummy(t, "dummy"), Pos: Hex{0, 1}}},
+		Config: Config{MaxTime: 0.5},
+	}
+	e, err := s.Engine()
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	c := e.Fighters()[0]
+	if c.Unit.Stats.Offense.AD != 30 || c.Unit.PowerUp != "Hexxed" {
+		t.Fatalf("AD %v, power-up %q", c.Unit.Stats.Offense.AD, c.Unit.PowerUp)
+	}
+	e.Run()
+	var spellDmg, hook float64
+	for _, row := range e.Result().Fighters[0].Breakdown {
+		switch {
+		case row.Source == SourceAbility:
+			spellDmg += row.Amount
+		case row.Source == SourcePowerUp && row.Label == "Hexxed":
+			hook += row.Amount
+		}
+	}
+	if !near(spellDmg, 300) || hook <= 0 {
+		t.Fatalf("2★ spell with +100%% base should deal 300 true damage (got %v), hook damage %v", spellDmg, hook)
+	}
+
+	s.Blue[0].Unit.PowerUp = "Ballistic"
+	if err := s.Validate(); err == nil {
+		t.Fatalf("expected an error for a unit built with another power-up")
+	}
+}
@@ -0,0 +1,109 @@
+package sim
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Tally is a fighter's running combat totals.
+type Tally struct {
+	DamageDealt    float64 `json:"damage_dealt"`    // post-mitigation, shields included
+	DamageTaken    float64 `json:"damage_taken"`    // HP lost
+	ShieldAbsorbed float64 `json:"shield_absorbed"` // damage taken by this fighter's shields
+	ShieldDecayed  float64 `json:"shield_decayed"`  // shield that expired unused
+	ShieldsGranted float64 `json:"shields_granted"` // shield amount this fighter gave (self included)
+
+	HealingDone      float64 `json:"healing_done"`      // HP restored by this fighter (self included)
+	HealingReceived  float64 `json:"healing_received"`  // HP restored to this fighter
+	Overheal         float64 `json:"overheal"`          // healing this fighter did past max HP
+	HealingPrevented float64 `json:"healing_prevented"` // healing to this fighter removed by Wound
+
+	// DamageBySource splits DamageDealt by attribution: "auto_attack",
+	// "ability", the hook/effect name for on-hit and over-time damage, or
+	// the summon name for damage of the fighter's summons.
+	DamageBySource map[string]float64 `json:"damage_by_source,omitempty"`
+}
+
+// FighterResult is one fighter's end-of-combat summary.
+type FighterResult struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Team  int       `json:"team"`
+	Owner uuid.UUID `json:"owner,omitzero"` // summons: the fighter credited with their damage
+	Alive bool      `json:"alive"`
+	HP    float64   `json:"hp"`
+	Tally
+
+	TimeAlive float64   `json:"time_alive"` // seconds until death (full duration if alive)
+	DPS       float64   `json:"dps"`        // DamageDealt / combat duration
+	Breakdown Breakdown `json:"breakdown"`  // DamageDealt by source kind, label and damage type
+}
+
+// TeamResult aggregates one team.
+type TeamResult struct {
+	Team        int      `json:"team"`
+	DamageDealt float64  `json:"damage_dealt"`
+	DPS         float64  `json:"dps"` // DamageDealt / combat duration
+	Survivors   []string `json:"survivors"`
+}
+
+// Result summarizes a combat.
+type Result struct {
+	Duration float64         `json:"duration"`
+	Winner   int             `json:"winner"`            // -1 while several teams are alive (timeout) or none is
+	Fighters []FighterResult `json:"fighters"`          // board units, insertion order
+	Summons  []FighterResult `json:"summons,omitempty"` // units spawned mid-fight, spawn order
+	Teams    []TeamResult    `json:"teams"`             // ascending team number
+}
+
+// Result summarizes the combat so far.
+func (e *Engine) Result() Result {
+	r := Result{Duration: e.now, Winner: -1}
+	teams := map[int]*TeamResult{}
+	for _, f := range e.fighters {
+		tally := f.tally
+		tally.DamageBySource = maps.Clone(tally.DamageBySource)
+		fr := FighterResult{
+			ID: f.Unit.ID, Name: f.Unit.Name, Team: f.Team, Alive: f.alive, HP: f.hp, Tally: tally,
+			TimeAlive: e.now, DPS: perSecond(tally.DamageDealt, e.now),
+			Breakdown: newBreakdown(f.dealt, 1),
+		}
+		if !f.alive {
+			fr.TimeAlive = f.diedAt
+		}
+		if f.owner != nil {
+			fr.Owner = f.owner.Unit.ID
+			r.Summons = append(r.Summons, fr)
+		} else {
+			r.Fighters = append(r.Fighters, fr)
+		}
+
+		tr, ok := teams[f.Team]
+		if !ok {
+			tr = &TeamResult{Team: f.Team, Survivors: []string{}}
+			teams[f.Team] = tr
+		}
+		tr.DamageDealt += tally.DamageDealt
+		if f.alive && f.targetable() {
+			tr.Survivors = append(tr.Survivors, f.Unit.Name)
+			if e.Over() {
+				r.Winner = f.Team
+			}
+		}
+	}
+	for _, team := range slices.Sorted(maps.Keys(teams)) {
+		tr := teams[team]
+		tr.DPS = perSecond(tr.DamageDealt, e.now)
+		r.Teams = append(r.Teams, *tr)
+	}
+	return r
+}
+
+func perSecond(total, seconds float64) float64 {
+	if seconds <= 0 {
+		return 0
+	}
+	return total / seconds
+}
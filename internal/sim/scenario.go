@@ -0,0 +1,173 @@
+package sim
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/augments"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/items"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/powerups"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Team numbers used by Scenario.
+const (
+	TeamBlue = 0
+	TeamRed  = 1
+)
+
+// Placement is one unit on a board.
+type Placement struct {
+	Unit    units.Unit
+	Pos     Hex
+	Ability *abilities.Ability // nil → auto attacks only
+	Star    int                // ability star level (0 → 1)
+	OnHit   []OnHit
+	Items   []items.Item // equipped when the fight is built (stats and passives)
+	Hooks   []CombatHook
+	PowerUp *powerups.PowerUp // attached when the fight is built unless Unit already carries it
+}
+
+// Board is one side's units.
+type Board []Placement
+
+// Scenario is a team-vs-team fight: both boards share one hex grid, so
+// positions are absolute. It runs until one side is wiped or Config.MaxTime.
+type Scenario struct {
+	Name   string
+	Blue   Board
+	Red    Board
+	Config Config
+
+	// Augments are each player's augments, indexed by team: they apply to
+	// every unit of that board.
+	Augments [2][]augments.Augment
+}
+
+// Validate checks both boards are non-empty, units are valid with their items
+// and augments, and no two units share a hex.
+func (s Scenario) Validate() error {
+	var issues []string
+	occupied := map[Hex]string{}
+	check := func(side string, b Board) {
+		if len(b) == 0 {
+			issues = append(issues, fmt.Sprintf("%s: empty board", side))
+		}
+		for i, p := range b {
+			label := fmt.Sprintf("%s[%d] %s", side, i, p.Unit.Name)
+			if err := p.Unit.Stats.Validate(); err != nil {
+				issues = append(issues, fmt.Sprintf("%s: %v", label, err))
+			} else if _, err := p.equipped(); err != nil {
+				issues = append(issues, fmt.Sprintf("%s: %v", label, err))
+			}
+			if other, ok := occupied[p.Pos]; ok {
+				issues = append(issues, fmt.Sprintf("%s: hex %v already taken by %s", label, p.Pos, other))
+			}
+			occupied[p.Pos] = label
+		}
+	}
+	check("blue", s.Blue)
+	check("red", s.Red)
+	for team, side := range []string{TeamBlue: "blue", TeamRed: "red"} {
+		if _, err := withAugments(s.board(team), s.Augments[team]); err != nil {
+			issues = append(issues, fmt.Sprintf("%s augments: %v", side, err))
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	sort.Strings(issues)
+	return fmt.Errorf("scenario %q validation issues: %v", s.Name, issues)
+}
+
+// Engine builds a ready-to-run engine for the scenario (blue first, in board order).
+func (s Scenario) Engine() (*Engine, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	e := New(s.Config)
+	for team := range s.Augments {
+		e.traitBonus[team] = augments.TraitBonus(s.Augments[team]...)
+		b, _ := withAugments(s.board(team), s.Augments[team]) // checked by Validate
+		for _, p := range b {
+			p, _ := p.equipped() // checked by Validate
+			var opts []FighterOption
+			if p.Ability != nil {
+				opts = append(opts, WithAbility(*p.Ability, max(p.Star, 1)))
+			}
+			opts = append(opts, WithOnHit(p.OnHit...), WithHooks(p.Hooks...))
+			e.Add(p.Unit, team, p.Pos, opts...)
+		}
+	}
+	return e, nil
+}
+
+// Traits reports the trait standing of a team's board as it fights: emblems
+// held or granted by augments add their trait to the holder, and augment
+// trait grants add to the counts.
+func (s Scenario) Traits(team int, catalog units.TraitsLoader) ([]units.ActiveTrait, error) {
+	b, err := withAugments(s.board(team), s.Augments[team])
+	if err != nil {
+		return nil, err
+	}
+	board := make([]units.Unit, 0, len(b))
+	for _, p := range b {
+		p, err := p.equipped()
+		if err != nil {
+			return nil, err
+		}
+		board = append(board, p.Unit)
+	}
+	return catalog.ActiveWith(board, augments.TraitBonus(s.Augments[team]...)), nil
+}
+
+func (s Scenario) board(team int) Board {
+	if team == TeamBlue {
+		return s.Blue
+	}
+	return s.Red
+}
+
+// Run simulates the scenario once with Config.Seed.
+func (s Scenario) Run() (Result, error) {
+	e, err := s.Engine()
+	if err != nil {
+		return Result{}, err
+	}
+	e.Run()
+	return e.Result(), nil
+}
+
+// Locate finds a unit by name in fighter order (blue then red). Names match
+// case-insensitively, surrounding spaces ignored.
+func (s Scenario) Locate(name string) (int, bool) {
+	for i, p := range slices.Concat(s.Blue, s.Red) {
+		if strings.EqualFold(p.Unit.Name, strings.TrimSpace(name)) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// PlacementAt returns the placement of fighter i (fighter order) and the
+// enemy board.
+func (s Scenario) PlacementAt(i int) (Placement, Board) {
+	if i < len(s.Blue) {
+		return s.Blue[i], s.Red
+	}
+	return s.Red[i-len(s.Blue)], s.Blue
+}
+
+// WithPlacement returns a copy of s with fighter i replaced by p.
+func (s Scenario) WithPlacement(i int, p Placement) Scenario {
+	s.Blue, s.Red = slices.Clone(s.Blue), slices.Clone(s.Red)
+	if i < len(s.Blue) {
+		s.Blue[i] = p
+	} else {
+		s.Red[i-len(s.Blue)] = p
+	}
+	return s
+}
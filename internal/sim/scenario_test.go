@@ -0,0 +1,86 @@
+package sim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func testScenario(t *testing.T, seed uint64) Scenario {
+	t.Helper()
+	bolt := testAbility(0.5)
+	return Scenario{
+		Name: "2v2",
+		Blue: Board{
+			{Unit: testUnit(t, "tank", units.WithHP(1500), units.WithArmor(60), units.WithAD(40), units.WithAS(0.6)), Pos: Hex{0, 1}},
+			{Unit: testUnit(t, "carry", units.WithHP(700), units.WithAD(70), units.WithAS(0.9), units.WithRange(4), units.WithMana(0, 40, 0, 0, 10)),
+				Pos: Hex{0, 0}, Ability: &bolt, Star: 2},
+		},
+		Red: Board{
+			{Unit: testUnit(t, "bruiser", units.WithHP(900), units.WithAD(50), units.WithAS(0.7)), Pos: Hex{0, 5}},
+			{Unit: testUnit(t, "archer", units.WithHP(500), units.WithAD(45), units.WithAS(0.8), units.WithRange(4)), Pos: Hex{1, 5}},
+		},
+		Config: Config{Seed: seed},
+	}
+}
+
+func TestScenario_TeamFight(t *testing.T) {
+	t.Parallel()
+	r, err := testScenario(t, 3).Run()
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if r.Winner != TeamBlue && r.Winner != TeamRed {
+		t.Fatalf("expected a winner before the timeout, got %d after %.1fs", r.Winner, r.Duration)
+	}
+	if len(r.Teams) != 2 || len(r.Teams[r.Winner].Survivors) == 0 || len(r.Teams[1-r.Winner].Survivors) != 0 {
+		t.Fatalf("survivors: %+v", r.Teams)
+	}
+	for _, tr := range r.Teams {
+		sum := 0.0
+		for _, fr := range r.Fighters {
+			if fr.Team == tr.Team {
+				sum += fr.DamageDealt
+			}
+		}
+		if !near(tr.DamageDealt, sum) || !near(tr.DPS, sum/r.Duration) {
+			t.Fatalf("team %d totals: %+v (sum %v)", tr.Team, tr, sum)
+		}
+	}
+	for _, fr := range r.Fighters {
+		if !fr.Alive && fr.TimeAlive > r.Duration {
+			t.Fatalf("%s died after the fight ended", fr.Name)
+		}
+		if fr.Alive && fr.TimeAlive != r.Duration {
+			t.Fatalf("%s survived: time alive should be the full fight", fr.Name)
+		}
+	}
+	if r.Fighters[1].DamageBySource[string(SourceAbility)] == 0 {
+		t.Fatalf("the carry should have cast: %+v", r.Fighters[1].DamageBySource)
+	}
+}
+
+func TestScenario_Deterministic(t *testing.T) {
+	t.Parallel()
+	a, _ := testScenario(t, 11).Run()
+	b, _ := testScenario(t, 11).Run()
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("same scenario and seed must give the same result")
+	}
+}
+
+func TestScenario_Validate(t *testing.T) {
+	t.Parallel()
+	s := testScenario(t, 1)
+	s.Red[0].Pos = s.Blue[0].Pos
+	s.Blue = append(Board{}, s.Blue...)
+	_, err := s.Run()
+	if err == nil || !strings.Contains(err.Error(), "already taken by blue[0] tank") {
+		t.Fatalf("expected an overlap error, got %v", err)
+	}
+	if err := (Scenario{Name: "empty"}).Validate(); err == nil || !strings.Contains(err.Error(), "blue: empty board") {
+		t.Fatalf("expected empty board errors, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package sim
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// DefaultDeltas are the perturbations used for common stats: roughly one
+// item component's worth. Other paths use DefaultRelativeDelta.
+var DefaultDeltas = map[string]float64{
+	"offense.attack_damage":          10,
+	"offense.ability_power":          10,
+	"offense.attack_speed":           0.1,
+	"offense.critical_strike_chance": 0.1,
+	"offense.critical_strike_damage": 0.1,
+	"offense.damage_amp":             0.1,
+	"defense.hp":                     100,
+	"defense.armor":                  10,
+	"defense.magic_resist":           10,
+	"resource.mana_per_hit":          1,
+	"resource.mana_start":            10,
+}
+
+// DefaultRelativeDelta perturbs paths without an explicit delta by this
+// fraction of their current value (zero-valued ones are skipped).
+const DefaultRelativeDelta = 0.1
+
+// SensitivityConfig tunes Sensitivity.
+type SensitivityConfig struct {
+	Paths  []string           // stat paths or aliases to perturb (default: every stat path)
+	Deltas map[string]float64 // per-path deltas, overriding DefaultDeltas
+	MonteCarloConfig
+}
+
+// StatWeight is the marginal value of one stat for the unit.
+type StatWeight struct {
+	Path       string  `json:"path"`
+	Delta      float64 `json:"delta"`
+	DPS        float64 `json:"dps"`        // mean DPS with the perturbed stat
+	Gain       float64 `json:"gain"`       // DPS − base DPS
+	PerUnit    float64 `json:"per_unit"`   // Gain / Delta: DPS per point of the stat
+	Normalized float64 `json:"normalized"` // Gain / largest |Gain|, in [-1,1]
+	Rank       int     `json:"rank"`       // 1 = most valuable perturbation
+}
+
+// SensitivityResult ranks stats by how much DPS their delta adds.
+type SensitivityResult struct {
+	Unit    string       `json:"unit"`
+	BaseDPS float64      `json:"base_dps"`
+	Weights []StatWeight `json:"weights"` // by Rank
+	Skipped []string     `json:"skipped,omitempty"`
+}
+
+// Sensitivity perturbs each stat of the named unit by its delta, re-runs the
+// Monte-Carlo with the same seeds (common random numbers, so gains are not
+// drowned in run-to-run noise) and reports the marginal DPS per stat.
+func Sensitivity(s Scenario, unit string, cfg SensitivityConfig) (SensitivityResult, error) {
+	idx, ok := s.Locate(unit)
+	if !ok {
+		return SensitivityResult{}, fmt.Errorf("scenario %q: no unit named %q", s.Name, unit)
+	}
+	p, _ := s.PlacementAt(idx)
+	deltas, err := resolveDeltas(cfg.Deltas)
+	if err != nil {
+		return SensitivityResult{}, err
+	}
+	base, err := MonteCarlo(s, cfg.MonteCarloConfig)
+	if err != nil {
+		return SensitivityResult{}, err
+	}
+	out := SensitivityResult{Unit: p.Unit.Name, BaseDPS: base.Units[idx].DPS}
+
+	paths := cfg.Paths
+	if len(paths) == 0 {
+		paths = units.StatPaths()
+	}
+	stats := p.Unit.Stats
+	for _, raw := range paths {
+		path, ok := units.ResolveStatPath(raw)
+		if !ok {
+			return SensitivityResult{}, fmt.Errorf("unknown stat path %q", raw)
+		}
+		delta, ok := statDelta(path, stats, deltas)
+		if !ok {
+			out.Skipped = append(out.Skipped, path)
+			continue
+		}
+		v, _ := stats.Value(path)
+		next := stats
+		next.SetValue(path, v+delta)
+		if next, err = next.With(); err != nil {
+			out.Skipped = append(out.Skipped, path)
+			continue
+		}
+		q := p
+		q.Unit.Stats = next
+		perturbed := s.WithPlacement(idx, q)
+		mc, err := MonteCarlo(perturbed, cfg.MonteCarloConfig)
+		if err != nil {
+			return SensitivityResult{}, fmt.Errorf("%s: %w", path, err)
+		}
+		dps := mc.Units[idx].DPS
+		out.Weights = append(out.Weights, StatWeight{
+			Path: path, Delta: delta, DPS: dps, Gain: dps - out.BaseDPS, PerUnit: (dps - out.BaseDPS) / delta,
+		})
+	}
+	rankWeights(out.Weights)
+	return out, nil
+}
+
+// resolveDeltas keys the configured deltas by full stat path. Two aliases of
+// one path with different deltas are an error rather than a coin flip.
+func resolveDeltas(raw map[string]float64) (map[string]float64, error) {
+	out := make(map[string]float64, len(raw))
+	from := map[string]string{}
+	for _, k := range slices.Sorted(maps.Keys(raw)) {
+		path, ok := units.ResolveStatPath(k)
+		if !ok {
+			return nil, fmt.Errorf("deltas: unknown stat path %q", k)
+		}
+		if prev, dup := from[path]; dup && out[path] != raw[k] {
+			return nil, fmt.Errorf("deltas: %q and %q both set %s to different deltas", prev, k, path)
+		}
+		out[path], from[path] = raw[k], k
+	}
+	return out, nil
+}
+
+func statDelta(path string, s units.Stats, overrides map[string]float64) (float64, bool) {
+	if d := overrides[path]; d != 0 {
+		return d, true
+	}
+	if d, ok := DefaultDeltas[path]; ok {
+		return d, true
+	}
+	v, _ := s.Value(path)
+	if v == 0 {
+		return 0, false
+	}
+	return math.Abs(v) * DefaultRelativeDelta, true
+}
+
+func rankWeights(ws []StatWeight) {
+	best := 0.0
+	for _, w := range ws {
+		best = math.Max(best, math.Abs(w.Gain))
+	}
+	slices.SortStableFunc(ws, func(a, b StatWeight) int {
+		return cmp.Or(cmp.Compare(b.Gain, a.Gain), cmp.Compare(a.Path, b.Path))
+	})
+	for i := range ws {
+		ws[i].Rank = i + 1
+		if best > 0 {
+			ws[i].Normalized = ws[i].Gain / best
+		}
+	}
+}
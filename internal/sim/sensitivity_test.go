@@ -0,0 +1,74 @@
+package sim
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestSensitivity_RanksStats(t *testing.T) {
+	t.Parallel()
+	bolt := testAbility(1)
+	s := Scenario{
+		Name: "weights",
+		Blue: Board{{Unit: testUnit(t, "carry", units.WithAD(60), units.WithAS(0.8), units.WithMana(0, 60, 0, 0, 10)),
+			Pos: Hex{0, 0}, Ability: &bolt}},
+		Red:    Board{{Unit: dummy(t, "dummy", units.WithArmor(40)), Pos: Hex{0, 1}}},
+		Config: Config{Seed: 1, MaxTime: 30},
+	}
+	res, err := Sensitivity(s, "carry", SensitivityConfig{
+		Paths:            []string{"AD", "attack_speed", "AP", "defense.armor", "omnivamp_min"},
+		Deltas:           map[string]float64{"AD": 20},
+		MonteCarloConfig: MonteCarloConfig{Runs: 20},
+	})
+	if err != nil {
+		t.Fatalf("sensitivity: %v", err)
+	}
+	if res.BaseDPS <= 0 || !slices.Equal(res.Skipped, []string{"offense.omnivamp.omnivamp_min"}) {
+		t.Fatalf("base %v, skipped %v", res.BaseDPS, res.Skipped)
+	}
+	w := map[string]StatWeight{}
+	for _, sw := range res.Weights {
+		w[sw.Path] = sw
+	}
+	ad, as := w["offense.attack_damage"], w["offense.attack_speed"]
+	if ad.Delta != 20 || ad.Gain <= 0 || as.Gain <= 0 || !near(ad.PerUnit, ad.Gain/20) {
+		t.Fatalf("AD/AS should add DPS: %+v %+v", ad, as)
+	}
+	if w["offense.ability_power"].Gain != 0 || w["defense.armor"].Gain != 0 {
+		t.Fatalf("AP (no ratio) and armor (passive enemy) are worthless here: %+v", res.Weights)
+	}
+	top := res.Weights[0]
+	if top.Rank != 1 || top.Normalized != 1 || res.Weights[len(res.Weights)-1].Rank != len(res.Weights) {
+		t.Fatalf("ranking: %+v", res.Weights)
+	}
+}
+
+func TestSensitivity_UnknownUnit(t *testing.T) {
+	t.Parallel()
+	if _, err := Sensitivity(testScenario(t, 1), "nobody", SensitivityConfig{}); err == nil {
+		t.Fatalf("expected an error")
+	}
+	res, err := Sensitivity(testScenario(t, 1), " CARRY ", SensitivityConfig{Paths: []string{"AD"}, MonteCarloConfig: MonteCarloConfig{Runs: 4}})
+	if err != nil || res.Unit != "carry" {
+		t.Fatalf("names should match like the optimizers do: %q, %v", res.Unit, err)
+	}
+}
+
+func TestSensitivity_ConflictingDeltaAliases(t *testing.T) {
+	t.Parallel()
+	cfg := SensitivityConfig{Paths: []string{"AD"}, Deltas: map[string]float64{"ad": 10, "attack_damage": 20}, MonteCarloConfig: MonteCarloConfig{Runs: 2}}
+	if _, err := Sensitivity(testScenario(t, 1), "carry", cfg); err == nil {
+		t.Fatalf("expected an error for two aliases with different deltas")
+	}
+	cfg.Deltas = map[string]float64{"ad": 20, "attack_damage": 20}
+	res, err := Sensitivity(testScenario(t, 1), "carry", cfg)
+	if err != nil || res.Weights[0].Delta != 20 {
+		t.Fatalf("agreeing aliases: %+v, %v", res.Weights, err)
+	}
+	cfg.Deltas = map[string]float64{"atack_damage": 20}
+	if _, err := Sensitivity(testScenario(t, 1), "carry", cfg); err == nil {
+		t.Fatalf("expected an error for an unknown delta key")
+	}
+}
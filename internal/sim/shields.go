@@ -0,0 +1,100 @@
+package sim
+
+import (
+	"math"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Shield is a runtime damage absorption layer. The same value can be granted many times.
+type Shield struct {
+	Name     string
+	Amount   float64
+	Duration float64          // seconds; <= 0 lasts until broken or combat ends
+	Type     units.DamageType // "" absorbs every damage type; otherwise only that type
+}
+
+// shieldInstance is a shield active on one fighter.
+type shieldInstance struct {
+	Shield
+	source    *Fighter
+	remaining float64
+	expiresAt float64 // +Inf when permanent
+	seq       int     // application order; last tie-breaker when consuming
+}
+
+// AddShield grants s from src (may be nil) to dst. Non-positive amounts are ignored.
+func (e *Engine) AddShield(src, dst *Fighter, s Shield) {
+	if dst == nil || !dst.alive || s.Amount <= 0 {
+		return
+	}
+	inst := &shieldInstance{Shield: s, source: src, remaining: s.Amount, expiresAt: math.Inf(1), seq: e.shieldSeq}
+	e.shieldSeq++
+	dst.shields = append(dst.shields, inst)
+	if src != nil {
+		src.tally.ShieldsGranted += s.Amount
+	}
+	e.emit(Event{Kind: EventShieldApplied, Source: fighterID(src), Target: dst.Unit.ID, Effect: s.Name, Amount: s.Amount, DamageType: s.Type})
+
+	if s.Duration > 0 {
+		inst.expiresAt = e.now + s.Duration
+		e.After(s.Duration, func() {
+			if !slices.Contains(dst.shields, inst) {
+				return // already broken
+			}
+			dst.tally.ShieldDecayed += inst.remaining
+			e.dropShield(dst, inst)
+			e.emit(Event{Kind: EventShieldExpired, Source: fighterID(src), Target: dst.Unit.ID, Effect: s.Name, Amount: inst.remaining})
+		})
+	}
+}
+
+// absorb consumes f's shields that apply to typ and returns the absorbed amount.
+// Order: type-restricted shields first (they are useless against anything
+// else), then the one expiring soonest, then the oldest.
+func (e *Engine) absorb(f *Fighter, amount float64, typ units.DamageType) float64 {
+	usable := make([]*shieldInstance, 0, len(f.shields))
+	for _, sh := range f.shields {
+		if sh.Type == "" || sh.Type == typ {
+			usable = append(usable, sh)
+		}
+	}
+	slices.SortFunc(usable, func(a, b *shieldInstance) int {
+		if ar, br := a.Type != "", b.Type != ""; ar != br {
+			if ar {
+				return -1
+			}
+			return 1
+		}
+		if a.expiresAt != b.expiresAt {
+			if a.expiresAt < b.expiresAt {
+				return -1
+			}
+			return 1
+		}
+		return a.seq - b.seq
+	})
+
+	absorbed := 0.0
+	for _, sh := range usable {
+		if amount <= 0 {
+			break
+		}
+		take := math.Min(amount, sh.remaining)
+		sh.remaining -= take
+		amount -= take
+		absorbed += take
+		if sh.remaining <= 0 {
+			e.dropShield(f, sh)
+			e.emit(Event{Kind: EventShieldBroken, Source: fighterID(sh.source), Target: f.Unit.ID, Effect: sh.Name})
+		}
+	}
+	return absorbed
+}
+
+func (e *Engine) dropShield(f *Fighter, inst *shieldInstance) {
+	if i := slices.Index(f.shields, inst); i >= 0 {
+		f.shields = slices.Delete(f.shields, i, i+1)
+	}
+}
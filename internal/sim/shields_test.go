@@ -0,0 +1,96 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func TestShields_AbsorbBeforeHP(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.AddShield(src, dst, Shield{Name: "Barrier", Amount: 300})
+
+	res := e.DealDamage(src, dst, Damage{Type: units.True, Amount: 200})
+	if !near(res.Absorbed, 200) || res.HPDamage != 0 || !near(dst.Shield(), 100) {
+		t.Fatalf("shield should take the whole hit: %+v shield=%v", res, dst.Shield())
+	}
+	res = e.DealDamage(src, dst, Damage{Type: units.True, Amount: 250})
+	if !near(res.Absorbed, 100) || !near(res.HPDamage, 150) || dst.Shield() != 0 {
+		t.Fatalf("overflow should reach HP: %+v", res)
+	}
+	if countEvents(e.Log(), EventShieldBroken, "Barrier") != 1 {
+		t.Fatalf("broken shield must be logged")
+	}
+	if tl := dst.Tally(); !near(tl.ShieldAbsorbed, 300) || !near(tl.DamageTaken, 150) {
+		t.Fatalf("tally: %+v", tl)
+	}
+	if !near(src.Tally().DamageDealt, 450) || !near(src.Tally().ShieldsGranted, 300) {
+		t.Fatalf("source tally: %+v", src.Tally())
+	}
+}
+
+func TestShields_AbsorbAfterMitigation(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t) // 100 armor → physical halved
+	e.AddShield(src, dst, Shield{Amount: 100})
+	res := e.DealDamage(src, dst, Damage{Type: units.Physical, Amount: 100})
+	if !near(res.Absorbed, 50) || !near(dst.Shield(), 50) {
+		t.Fatalf("shields absorb post-mitigation damage: %+v", res)
+	}
+}
+
+func TestShields_TypeRestrictedAndOrder(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.AddShield(src, dst, Shield{Name: "generic", Amount: 100})
+	e.AddShield(src, dst, Shield{Name: "short", Amount: 100, Duration: 2})
+	e.AddShield(src, dst, Shield{Name: "spell", Amount: 100, Type: units.Magic})
+
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 150})
+	log := e.Log()
+	if countEvents(log, EventShieldBroken, "short") != 1 || countEvents(log, EventShieldBroken, "spell") != 0 {
+		t.Fatalf("true damage must skip the magic shield and consume the shortest one first")
+	}
+	// magic shield goes before the generic one
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 1000}) // breaks generic (50 left)
+	res := e.DealDamage(src, dst, Damage{Type: units.Magic, Amount: 200})
+	if !near(res.Absorbed, 100) || dst.Shield() != 0 {
+		t.Fatalf("magic damage should hit the magic shield: %+v", res)
+	}
+}
+
+func TestShields_DecayOnExpiry(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.AddShield(src, dst, Shield{Name: "Temp", Amount: 200, Duration: 3})
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 50})
+	e.RunUntil(4)
+	if dst.Shield() != 0 || !near(dst.Tally().ShieldDecayed, 150) {
+		t.Fatalf("unused shield should decay: shield=%v tally=%+v", dst.Shield(), dst.Tally())
+	}
+	var expired *Event
+	for i, ev := range e.Log() {
+		if ev.Kind == EventShieldExpired {
+			expired = &e.Log()[i]
+		}
+	}
+	if expired == nil || !near(expired.Amount, 150) || !near(expired.T, 3) {
+		t.Fatalf("expiry must be logged with the decayed amount: %+v", expired)
+	}
+}
+
+func TestResult_ReportsAbsorbed(t *testing.T) {
+	t.Parallel()
+	e, src, dst := effectBoard(t)
+	e.AddShield(dst, dst, Shield{Amount: 80})
+	e.DealDamage(src, dst, Damage{Type: units.True, Amount: 100})
+	r := e.Result()
+	got := r.Fighters[dst.index]
+	if !near(got.ShieldAbsorbed, 80) || !near(got.DamageTaken, 20) || !near(got.ShieldsGranted, 80) {
+		t.Fatalf("result: %+v", got)
+	}
+	if r.Winner != -1 {
+		t.Fatalf("both teams alive: winner should be -1, got %d", r.Winner)
+	}
+}
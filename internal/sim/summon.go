@@ -0,0 +1,152 @@
+package sim
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// Summon is the template of a unit spawned mid-fight (see Engine.Summon).
+type Summon struct {
+	Name     string
+	Stats    units.Stats
+	Traits   []string
+	Ability  *abilities.Ability // nil → never casts
+	Star     int                // spell star level (default 1)
+	OnHit    []OnHit
+	Lifetime float64 // seconds before it expires (<= 0 → rest of combat)
+
+	// Untargetable summons are never picked by enemy attacks, spells or
+	// hooks, and do not keep their team in the fight.
+	Untargetable bool
+	// CountTraits counts the summon's Traits in Engine.Traits; by default
+	// summons add no trait.
+	CountTraits bool
+}
+
+// Summon spawns s for owner's team on the free board hex nearest to owner
+// (ties go to owner's side, front row first). The unit's ID is derived from
+// the owner's ID and insertion index, the summon name and how many units the
+// owner has summoned, so reruns get the same IDs. Damage the summon deals is
+// credited to the owner (or the owner's owner) as SourceSummon, labelled with
+// the summon name.
+func (e *Engine) Summon(owner *Fighter, s Summon) (*Fighter, error) {
+	if owner == nil {
+		return nil, fmt.Errorf("summon %q: no owner", s.Name)
+	}
+	if s.Name == "" {
+		return nil, fmt.Errorf("summon: empty name")
+	}
+	st, err := s.Stats.With()
+	if err != nil {
+		return nil, fmt.Errorf("summon %q: %w", s.Name, err)
+	}
+	if s.Ability != nil {
+		if err := s.Ability.Validate(); err != nil {
+			return nil, fmt.Errorf("summon %q: %w", s.Name, err)
+		}
+	}
+	pos, ok := e.freeHexNear(owner)
+	if !ok {
+		return nil, fmt.Errorf("summon %q: no free hex", s.Name)
+	}
+	root := owner
+	if owner.owner != nil {
+		root = owner.owner
+	}
+
+	// The owner's insertion index keeps owners sharing an ID (uuid.Nil in
+	// hand-built engines) from minting the same summon IDs.
+	ids := units.DeterministicIDs{Namespace: owner.Unit.ID, Seed: int64(owner.index)}
+	u := units.Unit{ID: ids.UnitID(s.Name, owner.summoned), Name: s.Name, Traits: slices.Clone(s.Traits), Stats: st}
+	owner.summoned++
+	opts := []FighterOption{WithOnHit(s.OnHit...)}
+	if s.Ability != nil {
+		opts = append(opts, WithAbility(*s.Ability, max(s.Star, 1)))
+	}
+	f := e.Add(u, owner.Team, pos, opts...)
+	f.owner, f.untargetable, f.countTraits = root, s.Untargetable, s.CountTraits
+	p := pos
+	e.emit(Event{Kind: EventSummon, Source: owner.Unit.ID, Target: u.ID, Effect: s.Name, Pos: &p})
+
+	if s.Lifetime > 0 {
+		e.After(s.Lifetime, func() { e.expire(f) })
+	}
+	if e.started && !f.hardCC() {
+		e.scheduleAct(f, 0)
+		if f.stats.Resource.ManaRegen > 0 && f.ability != nil {
+			e.scheduleRegen(f)
+		}
+	}
+	return f, nil
+}
+
+// castSummon spawns the summon of a spell; an invalid one (validated at load)
+// or a full board simply fizzles.
+func (e *Engine) castSummon(f *Fighter, s abilities.Summon) {
+	st, err := s.UnitStats()
+	if err != nil {
+		return
+	}
+	_, _ = e.Summon(f, Summon{
+		Name: s.Name, Stats: st, Traits: s.Traits, Lifetime: s.Lifetime,
+		Untargetable: s.Untargetable, CountTraits: s.CountTraits,
+	})
+}
+
+// expire removes a summon whose lifetime ran out.
+func (e *Engine) expire(f *Fighter) {
+	if !f.alive {
+		return
+	}
+	f.alive = false
+	f.diedAt = e.now
+	e.emit(Event{Kind: EventSummonExpired, Source: f.Unit.ID, Effect: f.Unit.Name})
+}
+
+// freeHexNear is the free board hex closest to f, preferring f's side and
+// then the front rows.
+func (e *Engine) freeHexNear(f *Fighter) (Hex, bool) {
+	other := TeamRed
+	if f.Team != TeamBlue {
+		other = TeamBlue
+	}
+	hexes := append(TeamHexes(f.Team), TeamHexes(other)...)
+	slices.SortStableFunc(hexes, func(a, b Hex) int { return cmp.Compare(f.Pos.Distance(a), f.Pos.Distance(b)) })
+	for _, h := range hexes {
+		if !e.occupied(h) {
+			return h, true
+		}
+	}
+	return Hex{}, false
+}
+
+// Summon spawns s for the hook's fighter (see Engine.Summon).
+func (c *HookContext) Summon(s Summon) (*Fighter, error) { return c.Engine.Summon(c.Self, s) }
+
+// Owner is the fighter a summon fights for (nil for board units).
+func (f *Fighter) Owner() *Fighter { return f.owner }
+
+// targetable reports whether enemies may pick f.
+func (f *Fighter) targetable() bool { return !f.untargetable }
+
+// Traits reports the trait standing of a team's fighters, dead ones
+// included: board units always count, summons only with Summon.CountTraits.
+// An engine built by Scenario.Engine adds the team's augment trait grants,
+// as Scenario.Traits does.
+func (e *Engine) Traits(team int, catalog units.TraitsLoader) []units.ActiveTrait {
+	var board []units.Unit
+	for _, f := range e.fighters {
+		if f.Team == team && (f.owner == nil || f.countTraits) {
+			board = append(board, f.Unit)
+		}
+	}
+	var bonus map[string]int
+	if team >= 0 && team < len(e.traitBonus) {
+		bonus = e.traitBonus[team]
+	}
+	return catalog.ActiveWith(board, bonus)
+}
@@ -0,0 +1,154 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/abilities"
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+func tibbers(t *testing.T, lifetime float64) Summon {
+	t.Helper()
+	st, err := units.NewStats(units.WithHP(500), units.WithAD(40), units.WithAS(1), units.WithRange(1))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	return Summon{Name: "Tibbers", Stats: st, Traits: []string{"Sorcerer"}, Lifetime: lifetime}
+}
+
+// summonAtStart is a combat hook that summons s once at the start of combat.
+func summonAtStart(t *testing.T, s Summon) CombatHook {
+	return CombatHook{Name: "summon", Trigger: TriggerStart, Fn: func(c *HookContext) {
+		if _, err := c.Summon(s); err != nil {
+			t.Errorf("summon: %v", err)
+		}
+	}}
+}
+
+func TestSummon_SpawnsNearOwnerAndCreditsDamage(t *testing.T) {
+	t.Parallel()
+	run := func() (*Engine, Result) {
+		e := New(Config{MaxTime: 4})
+		e.Add(testUnit(t, "annie"), TeamBlue, Cell(3, 0), WithHooks(summonAtStart(t, tibbers(t, 2.5))))
+		e.Add(dummy(t, "dummy"), TeamRed, Cell(3, 4))
+		e.Run()
+		return e, e.Result()
+	}
+	e, r := run()
+	if len(r.Fighters) != 2 || len(r.Summons) != 1 {
+		t.Fatalf("fighters %d, summons %d", len(r.Fighters), len(r.Summons))
+	}
+	annie, bear := r.Fighters[0], r.Summons[0]
+	if bear.Owner != annie.ID || bear.Alive || !near(bear.TimeAlive, 2.5) {
+		t.Fatalf("summon result %+v", bear)
+	}
+	if s := e.Fighters()[2]; s.Owner() != e.Fighters()[0] || s.Unit.Stats.Defense.HP != 500 {
+		t.Fatalf("summon fighter: owner %v, HP %v", s.Owner(), s.Unit.Stats.Defense.HP)
+	}
+	if countEvents(e.Log(), EventSummon, "Tibbers") != 1 || countEvents(e.Log(), EventSummonExpired, "Tibbers") != 1 {
+		t.Fatalf("summon events missing from the log")
+	}
+	var summoned float64
+	for _, row := range annie.Breakdown {
+		if row.Source == SourceSummon && row.Label == "Tibbers" {
+			summoned += row.Amount
+		}
+	}
+	if summoned <= 0 || bear.DamageDealt != 0 || !near(annie.DamageBySource["Tibbers"], summoned) {
+		t.Fatalf("summon damage %v should be credited to the owner (summon tally %v)", summoned, bear.DamageDealt)
+	}
+
+	_, again := run()
+	if again.Summons[0].ID != bear.ID || again.Summons[0].ID == annie.ID {
+		t.Fatalf("summon IDs are not deterministic: %v vs %v", again.Summons[0].ID, bear.ID)
+	}
+}
+
+func TestSummon_IDsDistinctForNilOwners(t *testing.T) {
+	t.Parallel()
+	e := New(Config{})
+	var ids []uuid.UUID
+	for i, pos := range []Hex{Cell(1, 0), Cell(5, 0)} {
+		u := testUnit(t, "owner")
+		u.ID = uuid.Nil
+		owner := e.Add(u, TeamBlue, pos)
+		bear, err := e.Summon(owner, tibbers(t, 0))
+		if err != nil {
+			t.Fatalf("summon %d: %v", i, err)
+		}
+		ids = append(ids, bear.Unit.ID)
+	}
+	if ids[0] == ids[1] {
+		t.Fatalf("owners without IDs minted the same summon ID %v", ids[0])
+	}
+}
+
+func TestSummon_TargetingAndTraits(t *testing.T) {
+	t.Parallel()
+	for _, untargetable := range []bool{false, true} {
+		e := New(Config{MaxTime: 20})
+		owner := e.Add(testUnit(t, "owner", units.WithHP(100)), TeamBlue, Cell(3, 0))
+		e.Add(testUnit(t, "brute", units.WithAD(200), units.WithAS(1)), TeamRed, Cell(3, 1))
+		s := tibbers(t, 0)
+		s.Stats.Offense.AD = 0
+		s.Untargetable = untargetable
+		bear, err := e.Summon(owner, s)
+		if err != nil {
+			t.Fatalf("summon: %v", err)
+		}
+		if d := bear.Pos.Distance(owner.Pos); d != 1 {
+			t.Fatalf("summoned %d hexes away from the owner", d)
+		}
+		e.Run()
+		r := e.Result()
+		switch {
+		case untargetable && (bear.Tally().DamageTaken != 0 || r.Winner != TeamRed):
+			t.Fatalf("untargetable summon: took %v damage, winner %d", bear.Tally().DamageTaken, r.Winner)
+		case !untargetable && bear.Tally().DamageTaken == 0:
+			t.Fatalf("targetable summon was never hit")
+		}
+	}
+
+	traits := units.TraitsLoader{Traits: []units.Trait{{Name: "Sorcerer", Kind: "class", Breakpoints: []int{2}}}}
+	e := New(Config{})
+	u := testUnit(t, "owner")
+	u.Traits = []string{"Sorcerer"}
+	owner := e.Add(u, TeamBlue, Cell(3, 0))
+	if _, err := e.Summon(owner, tibbers(t, 0)); err != nil {
+		t.Fatalf("summon: %v", err)
+	}
+	if got := e.Traits(TeamBlue, traits); got[0].Count != 1 {
+		t.Fatalf("summons count for traits by default: %+v", got)
+	}
+	s := tibbers(t, 0)
+	s.Name, s.CountTraits = "Tibbers II", true
+	if _, err := e.Summon(owner, s); err != nil {
+		t.Fatalf("summon: %v", err)
+	}
+	if got := e.Traits(TeamBlue, traits); got[0].Count != 2 || got[0].Tier != 1 {
+		t.Fatalf("CountTraits summon missing from trait count: %+v", got)
+	}
+
+	if _, err := e.Summon(owner, Summon{Name: "bad", Stats: units.Stats{Offense: units.OffenseStats{AS: -1}}}); err == nil {
+		t.Fatalf("expected an error for invalid summon stats")
+	}
+}
+
+func TestSummon_FromAbility(t *testing.T) {
+	t.Parallel()
+	spell := testAbility(0)
+	spell.Summon = &abilities.Summon{Name: "Voidling", Stats: map[string]float64{"HP": 300, "AD": 30, "AS": 1}, Lifetime: 3}
+	e := New(Config{MaxTime: 6})
+	f := e.Add(testUnit(t, "caster", units.WithMana(0, 30, 30, 0, 0)), TeamBlue, Cell(3, 3), WithAbility(spell, 1))
+	e.Add(dummy(t, "dummy"), TeamRed, Cell(3, 4))
+	e.Run()
+	r := e.Result()
+	if n := countEvents(e.Log(), EventCast, ""); n == 0 || len(r.Summons) != n {
+		t.Fatalf("%d casts, %d summons", n, len(r.Summons))
+	}
+	if r.Summons[0].Owner != f.Unit.ID || r.Summons[0].Name != "Voidling" {
+		t.Fatalf("summon result %+v", r.Summons[0])
+	}
+}
@@ -0,0 +1,42 @@
+package sim
+
+import "container/heap"
+
+// action is a scheduled callback. Ties on time run in scheduling order (seq).
+type action struct {
+	at  float64
+	seq uint64
+	fn  func()
+}
+
+type timeline struct {
+	items []action
+	seq   uint64
+}
+
+func (t *timeline) Len() int { return len(t.items) }
+func (t *timeline) Less(i, j int) bool {
+	a, b := t.items[i], t.items[j]
+	if a.at != b.at {
+		return a.at < b.at
+	}
+	return a.seq < b.seq
+}
+func (t *timeline) Swap(i, j int) { t.items[i], t.items[j] = t.items[j], t.items[i] }
+func (t *timeline) Push(x any)    { t.items = append(t.items, x.(action)) }
+func (t *timeline) Pop() any {
+	old := t.items
+	n := len(old)
+	it := old[n-1]
+	t.items = old[:n-1]
+	return it
+}
+
+func (t *timeline) schedule(at float64, fn func()) {
+	t.seq++
+	heap.Push(t, action{at: at, seq: t.seq, fn: fn})
+}
+
+func (t *timeline) pop() action { return heap.Pop(t).(action) }
+
+func (t *timeline) peekTime() float64 { return t.items[0].at }
@@ -0,0 +1,57 @@
+package sim
+
+import "math"
+
+// CastTimer decides what a cast does to the caster's attack timer.
+type CastTimer string
+
+const (
+	// CastDelay pauses the attack timer for the cast: time left before the
+	// next attack resumes when the cast ends (default).
+	CastDelay CastTimer = "delay"
+	// CastReset makes the next attack available as soon as the cast ends.
+	CastReset CastTimer = "reset"
+)
+
+// AttackTiming models when an auto attack lands. Zero fields take the defaults
+// below; the zero value is instant hits every 1/AS seconds with AS capped at 5.
+type AttackTiming struct {
+	// Windup is the fraction of the attack interval between starting an
+	// attack and releasing it, in [0,1). Hard CC during the windup cancels it.
+	Windup float64
+	// ProjectileSpeed is in hexes per second for ranged units (range > 1);
+	// travel time = distance / speed. 0 → instant.
+	ProjectileSpeed float64
+	// ASCap caps attack speed (default 5).
+	ASCap float64
+	// MinInterval is the shortest time between two attacks, in seconds.
+	MinInterval float64
+	// CastTimer is CastDelay (default) or CastReset.
+	CastTimer CastTimer
+}
+
+const defaultASCap = 5.0
+
+func (t AttackTiming) withDefaults() AttackTiming {
+	if t.ASCap <= 0 {
+		t.ASCap = defaultASCap
+	}
+	t.Windup = math.Min(math.Max(0, t.Windup), 0.99)
+	if t.CastTimer == "" {
+		t.CastTimer = CastDelay
+	}
+	return t
+}
+
+// interval is the time between two attacks at attack speed as.
+func (t AttackTiming) interval(as float64) float64 {
+	return math.Max(t.MinInterval, 1/math.Min(as, t.ASCap))
+}
+
+// travel is the projectile flight time from f to its target at distance dist.
+func (t AttackTiming) travel(f *Fighter, dist int) float64 {
+	if t.ProjectileSpeed <= 0 || f.stats.Offense.Range <= 1 {
+		return 0
+	}
+	return float64(dist) / t.ProjectileSpeed
+}
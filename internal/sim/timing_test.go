@@ -0,0 +1,83 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/0xm0-v1/simfight-tactics/internal/models/units"
+)
+
+// timed returns an engine with one attacker and a passive dummy dist hexes away.
+func timed(t *testing.T, timing AttackTiming, dist int, opts ...units.Option) (*Engine, *Fighter, *Fighter) {
+	t.Helper()
+	e := New(Config{MaxTime: 10, Timing: timing})
+	a := e.Add(testUnit(t, "attacker", append([]units.Option{units.WithAD(10)}, opts...)...), 0, Hex{0, 0},
+		WithAbility(testAbility(1), 1))
+	d := e.Add(dummy(t, "dummy", units.WithRange(10)), 1, Hex{0, dist}) // in range: never moves
+	return e, a, d
+}
+
+func damageTimes(log []Event, src *Fighter) []float64 {
+	return eventTimes(log, EventDamage, src)
+}
+
+func TestTiming_ASCapAndMinInterval(t *testing.T) {
+	t.Parallel()
+	e, a, _ := timed(t, AttackTiming{}, 1, units.WithAS(10))
+	e.RunUntil(0.5)
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{0, 0.2, 0.4}) {
+		t.Fatalf("AS 10 is capped at 5: %v", got)
+	}
+
+	e, a, _ = timed(t, AttackTiming{MinInterval: 0.3}, 1, units.WithAS(10))
+	e.RunUntil(0.7)
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{0, 0.3, 0.6}) {
+		t.Fatalf("min interval 0.3: %v", got)
+	}
+}
+
+func TestTiming_WindupAndProjectile(t *testing.T) {
+	t.Parallel()
+	e, a, _ := timed(t, AttackTiming{Windup: 0.25}, 1, units.WithAS(1))
+	e.RunUntil(1.5)
+	if got := damageTimes(e.Log(), a); !sameTimes(got, []float64{0.25, 1.25}) {
+		t.Fatalf("melee hits land after the windup: %v", got)
+	}
+
+	e, a, _ = timed(t, AttackTiming{Windup: 0.25, ProjectileSpeed: 2}, 4, units.WithAS(1), units.WithRange(4))
+	e.RunUntil(3)
+	if got := damageTimes(e.Log(), a); !sameTimes(got, []float64{2.25}) {
+		t.Fatalf("4 hexes at 2 hex/s add 2s of travel: %v", got)
+	}
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{0.25, 1.25, 2.25}) {
+		t.Fatalf("projectiles in flight do not delay the next attack: %v", got)
+	}
+}
+
+func TestTiming_StunCancelsWindup(t *testing.T) {
+	t.Parallel()
+	e, a, d := timed(t, AttackTiming{Windup: 0.5}, 1, units.WithAS(1))
+	e.RunUntil(0.25)
+	e.ApplyEffect(d, a, Stun(1))
+	e.RunUntil(2)
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{1.75}) {
+		t.Fatalf("the cancelled attack restarts when the stun ends at 1.25: %v", got)
+	}
+}
+
+func TestTiming_CastDelaysOrResetsAttackTimer(t *testing.T) {
+	t.Parallel()
+	// AS 0.5 → 2s interval; the first hit fills mana → 1s cast from t=0.
+	opts := []units.Option{units.WithAS(0.5), units.WithMana(0, 10, 0, 0, 10)}
+
+	e, a, _ := timed(t, AttackTiming{}, 1, opts...)
+	e.RunUntil(3.5)
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{0, 3}) {
+		t.Fatalf("delay: the 1s cast pushes the next attack from 2 to 3: %v", got)
+	}
+
+	e, a, _ = timed(t, AttackTiming{CastTimer: CastReset}, 1, opts...)
+	e.RunUntil(1.5)
+	if got := eventTimes(e.Log(), EventAttack, a); !sameTimes(got, []float64{0, 1}) {
+		t.Fatalf("reset: attack right after the cast ends: %v", got)
+	}
+}
@@ -0,0 +1,76 @@
+// Package suggest computes "did you mean" hints for mistyped config strings.
+package suggest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Distance returns the optimal-string-alignment distance between a and b:
+// Levenshtein plus adjacent transpositions ("tnak" → "tank" costs 1), rune-based.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+	// Three rolling rows: i-2, i-1, i.
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+// Closest returns the candidate nearest to s (case-insensitive) when it is
+// close enough to be a plausible typo: distance <= max(1, len(s)/3).
+// Ties resolve to the lexicographically smallest candidate for determinism.
+func Closest(s string, candidates []string) (string, bool) {
+	needle := strings.ToLower(strings.TrimSpace(s))
+	if needle == "" {
+		return "", false
+	}
+	maxDist := max(1, len([]rune(needle))/3)
+
+	sorted := append([]string(nil), candidates...)
+	sort.Strings(sorted)
+
+	best, bestDist := "", maxDist+1
+	for _, c := range sorted {
+		d := Distance(needle, strings.ToLower(c))
+		if d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	if best == "" || strings.EqualFold(best, needle) {
+		return "", false
+	}
+	return best, true
+}
+
+// DidYouMean formats an optional suggestion suffix for error messages.
+func DidYouMean(suggestion string) string {
+	if suggestion == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %q?)", suggestion)
+}
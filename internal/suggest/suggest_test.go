@@ -0,0 +1,42 @@
+package suggest
+
+import "testing"
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"atack", "attack", 1},
+		{"marksmen", "marksman", 1},
+		{"kitten", "sitting", 3},
+		{"tnak", "tank", 1}, // adjacent transposition
+		{"ca", "abc", 3},    // OSA: no edits on a transposed substring
+	}
+	for _, c := range cases {
+		if got := Distance(c.a, c.b); got != c.want {
+			t.Fatalf("Distance(%q,%q)=%d want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestClosest_ThresholdAndTies(t *testing.T) {
+	t.Parallel()
+	cands := []string{"tank", "fighter", "marksman"}
+	if m, ok := Closest("Marksmen", cands); !ok || m != "marksman" {
+		t.Fatalf("expected marksman, got %q ok=%v", m, ok)
+	}
+	if _, ok := Closest("wizard", cands); ok {
+		t.Fatalf("far-off input must not produce a suggestion")
+	}
+	if _, ok := Closest("tank", cands); ok {
+		t.Fatalf("exact match is not a suggestion")
+	}
+	// Tie at distance 1: lexicographically smallest wins.
+	if m, _ := Closest("bat", []string{"cat", "bar"}); m != "bar" {
+		t.Fatalf("expected deterministic tie-break to 'bar', got %q", m)
+	}
+}